@@ -6,3 +6,13 @@ data "twingate_connectors" "all" {
   #  name_prefix = "<prefix of connector name>"
   #  name_suffix = "<suffix of connector name>"
 }
+
+# All disconnected connectors running a version older than 1.70.0
+data "twingate_connectors" "outdated" {
+  states             = ["DEAD_NO_HEARTBEAT", "DEAD_HEARTBEAT_TOO_OLD", "DEAD_NO_RELAYS"]
+  version_constraint = "< 1.70.0"
+  #  remote_network_id = "<your remote network's id>"
+  #  remote_network_name = "<your remote network's name>"
+  #  hostname = "<hostname of the connector's machine>"
+  #  ip_address = "<IP or CIDR of the connector's public or private IPs>"
+}
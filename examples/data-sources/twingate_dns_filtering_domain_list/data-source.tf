@@ -0,0 +1,22 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+data "http" "blocklist" {
+  url = "https://example.com/hosts.txt"
+}
+
+data "twingate_dns_filtering_domain_list" "blocklist" {
+  content = data.http.blocklist.response_body
+  format  = "hosts"
+}
+
+resource "twingate_dns_filtering_profile" "example" {
+  name     = "Example DNS Filtering Profile"
+  priority = 2
+
+  denied_domains {
+    domains = data.twingate_dns_filtering_domain_list.blocklist.domains
+  }
+}
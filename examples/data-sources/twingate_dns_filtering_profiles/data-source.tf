@@ -0,0 +1,15 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+data "twingate_dns_filtering_profiles" "all" {}
+
+data "twingate_dns_filtering_profiles" "strict" {
+  fallback_method = "STRICT"
+  min_priority    = 2
+}
+
+output "groups_without_dns_filtering" {
+  value = data.twingate_dns_filtering_profiles.all.uncovered_groups
+}
@@ -0,0 +1,11 @@
+data "twingate_resource_conflicts" "aws" {
+  remote_network_id = "<your remote network's id>"
+  #  conflict_types = ["ADDRESS_OVERLAP", "SHADOWED_WILDCARD", "PORT_COLLISION"]
+}
+
+check "no_resource_conflicts" {
+  assert {
+    condition     = length(data.twingate_resource_conflicts.aws.conflicts) == 0
+    error_message = "Resources in the Remote Network conflict with each other: ${join("; ", data.twingate_resource_conflicts.aws.conflicts[*].description)}"
+  }
+}
@@ -1,3 +1,12 @@
 data "twingate_security_policy" "foo" {
   name = "<your security policy name>"
 }
+
+data "twingate_security_policy" "audit" {
+  name          = "<your security policy name>"
+  include_usage = true
+}
+
+output "resources_using_policy" {
+  value = data.twingate_security_policy.audit.resource_ids
+}
@@ -0,0 +1,12 @@
+data "twingate_service_account_keys" "expiring" {
+  service_account_id = "serviceAccountId"
+  status             = "ACTIVE"
+  expiring_within    = "14d"
+}
+
+check "service_keys_expiry" {
+  assert {
+    condition     = length(data.twingate_service_account_keys.expiring.keys) == 0
+    error_message = "Service Keys expiring within 14 days: ${join(", ", data.twingate_service_account_keys.expiring.keys[*].name)}"
+  }
+}
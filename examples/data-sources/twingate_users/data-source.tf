@@ -21,4 +21,21 @@ data "twingate_users" "all" {
   #  last_name_suffix = "<suffix of user last name>"
 
   #  roles = ["ADMIN", "DEVOPS", "SUPPORT", "MEMBER"]
+
+  #  state = "ACTIVE"
+  #  type = "MANUAL"
+  #  member_of_group = "<ID of a group>"
+  #  not_in_any_group = true
+}
+
+# Fail the plan when disabled users are still members of groups
+data "twingate_users" "disabled" {
+  state = "DISABLED"
+}
+
+check "offboarded_users_removed_from_groups" {
+  assert {
+    condition     = alltrue([for user in data.twingate_users.disabled.users : length(user.group_ids) == 0])
+    error_message = "Disabled users must be removed from all groups."
+  }
 }
@@ -0,0 +1,29 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_service_account" "github_actions_prod" {
+  name = "Github Actions PROD"
+}
+
+ephemeral "twingate_service_account_key" "github_key" {
+  service_account_id = twingate_service_account.github_actions_prod.id
+  name               = "Github Actions PROD key"
+  expiration_time    = 1
+}
+
+## Vault to store the token
+provider "vault" {
+  address = "https://vault.example.com"
+}
+
+resource "vault_kv_secret_v2" "twingate_service_key" {
+  mount = "secret"
+  name  = "twingate/github-actions-prod"
+
+  data_json_wo_version = 1
+  data_json_wo = jsonencode({
+    token = ephemeral.twingate_service_account_key.github_key.token
+  })
+}
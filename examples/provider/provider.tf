@@ -6,5 +6,13 @@ provider "twingate" {
     resource_enabled = false
     groups_enabled = true
   }
-}
 
+  client = {
+    page_size = 100
+
+    mutation = {
+      timeout     = "30s"
+      max_retries = 0
+    }
+  }
+}
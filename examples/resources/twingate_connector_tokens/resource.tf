@@ -14,3 +14,10 @@ resource "twingate_connector" "aws_connector" {
 resource "twingate_connector_tokens" "aws_connector_tokens" {
   connector_id = twingate_connector.aws_connector.id
 }
+
+// Regenerate the tokens every 90 days, or as soon as they are found revoked
+resource "twingate_connector_tokens" "aws_connector_tokens_with_rotation" {
+  connector_id      = twingate_connector.aws_connector.id
+  rotation_interval = "90d"
+  rotate_on_revoked = true
+}
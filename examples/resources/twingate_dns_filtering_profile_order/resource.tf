@@ -0,0 +1,29 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_dns_filtering_profile" "engineering" {
+  name     = "Engineering"
+  priority = 2
+
+  lifecycle {
+    ignore_changes = [priority]
+  }
+}
+
+resource "twingate_dns_filtering_profile" "everyone" {
+  name     = "Everyone"
+  priority = 1
+
+  lifecycle {
+    ignore_changes = [priority]
+  }
+}
+
+resource "twingate_dns_filtering_profile_order" "example" {
+  profile_ids = [
+    twingate_dns_filtering_profile.engineering.id,
+    twingate_dns_filtering_profile.everyone.id,
+  ]
+}
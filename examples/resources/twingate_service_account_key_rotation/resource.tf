@@ -0,0 +1,21 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_service_account" "github_actions_prod" {
+  name = "Github Actions PROD"
+}
+
+resource "twingate_service_account_key_rotation" "github_keys" {
+  service_account_id = twingate_service_account.github_actions_prod.id
+  name               = "github-actions-prod"
+  rotation_period    = "30d"
+  overlap            = "2d"
+  generations        = 3
+}
+
+output "github_token" {
+  value     = twingate_service_account_key_rotation.github_keys.current_token
+  sensitive = true
+}
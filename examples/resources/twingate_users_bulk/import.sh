@@ -0,0 +1 @@
+terraform import twingate_users_bulk.contractors "alice@company.com,bob@company.com"
\ No newline at end of file
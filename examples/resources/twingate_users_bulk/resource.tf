@@ -0,0 +1,28 @@
+provider "twingate" {
+  api_token = "1234567890abcdef"
+  network   = "mynetwork"
+}
+
+resource "twingate_group" "contractors" {
+  name = "Contractors"
+
+  lifecycle {
+    ignore_changes = [user_ids]
+  }
+}
+
+# contractors.csv:
+# email,first_name,last_name,role
+# alice@company.com,Alice,Smith,MEMBER
+# bob@company.com,Bob,Jones,SUPPORT
+resource "twingate_users_bulk" "contractors" {
+  users = [
+    for user in csvdecode(file("${path.module}/contractors.csv")) : {
+      email      = user.email
+      first_name = user.first_name
+      last_name  = user.last_name
+      role       = user.role
+      group_ids  = [twingate_group.contractors.id]
+    }
+  ]
+}
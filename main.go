@@ -32,6 +32,11 @@ func main() {
 		},
 	)
 
+	// flush the spans and metrics of the last operations
+	if shutdownErr := twingate.ShutdownTelemetry(context.Background()); shutdownErr != nil {
+		log.Printf("[TWINGATE_LOG] [ERR] Failed to shutdown telemetry: %v", shutdownErr)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,12 +1,14 @@
 package attr
 
 const (
-	ID                = "id"
-	Name              = "name"
-	RemoteNetworkID   = "remote_network_id"
-	RemoteNetworkName = "remote_network_name"
-	Type              = "type"
-	IsActive          = "is_active"
+	ID                 = "id"
+	Name               = "name"
+	RemoteNetworkID    = "remote_network_id"
+	RemoteNetworkName  = "remote_network_name"
+	RemoteNetworkType  = "remote_network_type"
+	Type               = "type"
+	IsActive           = "is_active"
+	DeletionProtection = "deletion_protection"
 
 	FilterByRegexp   = "_regexp"
 	FilterByContains = "_contains"
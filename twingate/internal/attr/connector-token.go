@@ -1,8 +1,10 @@
 package attr
 
 const (
-	ConnectorID  = "connector_id"
-	Keepers      = "keepers"
-	AccessToken  = "access_token"
-	RefreshToken = "refresh_token"
+	ConnectorID      = "connector_id"
+	Keepers          = "keepers"
+	AccessToken      = "access_token"
+	RefreshToken     = "refresh_token"
+	RotationInterval = "rotation_interval"
+	RotateOnRevoked  = "rotate_on_revoked"
 )
@@ -7,4 +7,7 @@ const (
 	Version              = "version"
 	PublicIP             = "public_ip"
 	PrivateIPs           = "private_ips"
+	States               = "states"
+	VersionConstraint    = "version_constraint"
+	IPAddress            = "ip_address"
 )
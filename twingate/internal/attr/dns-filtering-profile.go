@@ -30,4 +30,20 @@ const (
 	BlockPiracy                     = "block_piracy"
 	EnableYoutubeRestrictedMode     = "enable_youtube_restricted_mode"
 	EnableSafesearch                = "enable_safesearch"
+	FilePath                        = "path"
+	Format                          = "format"
+	Strict                          = "strict"
+	Hash                            = "hash"
+	DomainsCount                    = "domains_count"
+	InvalidDomains                  = "invalid_domains"
+	SkippedCount                    = "skipped_count"
+	ProfileIDs                      = "profile_ids"
+	BasePriority                    = "base_priority"
+	PriorityStep                    = "priority_step"
+	Priorities                      = "priorities"
+	DNSFilteringProfiles            = "dns_filtering_profiles"
+	MinPriority                     = "min_priority"
+	MaxPriority                     = "max_priority"
+	OverlappingGroups               = "overlapping_groups"
+	UncoveredGroups                 = "uncovered_groups"
 )
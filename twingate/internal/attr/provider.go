@@ -1,15 +1,33 @@
 package attr
 
 const (
-	APIToken        = "api_token"
-	Network         = "network"
-	URL             = "url"
-	HTTPTimeout     = "http_timeout"
-	HTTPMaxRetry    = "http_max_retry"
-	Cache           = "cache"
-	ResourceEnabled = "resource_enabled"
-	GroupsEnabled   = "groups_enabled"
-	DefaultTags     = "default_tags"
-	ResourcesFilter = "resources_filter"
-	GroupsFilter    = "groups_filter"
+	APIToken               = "api_token"
+	Network                = "network"
+	URL                    = "url"
+	HTTPTimeout            = "http_timeout"
+	HTTPMaxRetry           = "http_max_retry"
+	Cache                  = "cache"
+	ResourceEnabled        = "resource_enabled"
+	GroupsEnabled          = "groups_enabled"
+	DefaultTags            = "default_tags"
+	ResourcesFilter        = "resources_filter"
+	GroupsFilter           = "groups_filter"
+	Telemetry              = "telemetry"
+	Exporter               = "exporter"
+	Endpoint               = "endpoint"
+	HTTPProxy              = "http_proxy"
+	NoProxy                = "no_proxy"
+	CABundle               = "ca_bundle"
+	ClientCertFile         = "client_cert_file"
+	ClientKeyFile          = "client_key_file"
+	Client                 = "client"
+	PageSize               = "page_size"
+	MaxConcurrentMutations = "max_concurrent_mutations"
+	Query                  = "query"
+	Mutation               = "mutation"
+	Pagination             = "pagination"
+	Timeout                = "timeout"
+	MaxRetries             = "max_retries"
+	RetryWaitMin           = "retry_wait_min"
+	RetryWaitMax           = "retry_wait_max"
 )
@@ -0,0 +1,11 @@
+package attr
+
+const (
+	Conflicts                  = "conflicts"
+	ConflictTypes              = "conflict_types"
+	ResourceID                 = "resource_id"
+	ResourceAddress            = "resource_address"
+	ConflictingResourceID      = "conflicting_resource_id"
+	ConflictingResourceAddress = "conflicting_resource_address"
+	Description                = "description"
+)
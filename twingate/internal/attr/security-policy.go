@@ -2,4 +2,7 @@ package attr
 
 const (
 	SecurityPolicies = "security_policies"
+	PolicyType       = "policy_type"
+	IncludeUsage     = "include_usage"
+	AccessGroups     = "access_groups"
 )
@@ -4,4 +4,18 @@ const (
 	ServiceAccountID = "service_account_id"
 	Token            = "token"
 	ExpirationTime   = "expiration_time"
+	RotationPeriod   = "rotation_period"
+	Overlap          = "overlap"
+	Generations      = "generations"
+	CurrentKeyID     = "current_key_id"
+	CurrentToken     = "current_token"
+	PreviousKeyID    = "previous_key_id"
+	PreviousToken    = "previous_token"
+	RotatedAt        = "rotated_at"
+	Keys             = "keys"
+	Status           = "status"
+	CreatedAt        = "created_at"
+	ExpiresAt        = "expires_at"
+	DaysUntilExpiry  = "days_until_expiry"
+	ExpiringWithin   = "expiring_within"
 )
@@ -9,4 +9,7 @@ const (
 	Users      = "users"
 	State      = "state"
 	SendInvite = "send_invite"
+
+	MemberOfGroup = "member_of_group"
+	NotInAnyGroup = "not_in_any_group"
 )
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
+)
+
+// ValidateAPIToken validates the API token against the network with a single read query.
+func (client *Client) ValidateAPIToken(ctx context.Context) error {
+	opr := resourceAPIToken.read()
+
+	return client.queryWithTimeout(ctx, &query.ReadAPIToken{}, nil, opr)
+}
@@ -2,7 +2,7 @@ package client
 
 import (
 	"context"
-	"log"
+	"fmt"
 	"reflect"
 	"sync"
 
@@ -43,7 +43,7 @@ func (c *clientCache) setClient(ctx context.Context, client ReadClient, opts Cac
 			opts.ResourcesFilter.RemoteNetworkName != nil && *opts.ResourcesFilter.RemoteNetworkName != "" {
 			remoteNetwork, err := client.ReadRemoteNetworkByName(WithCallerCtx(ctx, cacheKey), *opts.ResourcesFilter.RemoteNetworkName)
 			if err != nil {
-				log.Printf("[TWINGATE_LOG] [ERR] cache init failed to fetch remote network by name - %s: %s", *opts.ResourcesFilter.RemoteNetworkName, err.Error())
+				logError(ctx, "Cache init failed to fetch the remote network by name", map[string]any{"remote_network_name": *opts.ResourcesFilter.RemoteNetworkName, logFieldError: err.Error()})
 			} else if remoteNetwork != nil {
 				opts.ResourcesFilter.RemoteNetworkID = &remoteNetwork.ID
 			}
@@ -69,17 +69,17 @@ func (c *clientCache) setClient(ctx context.Context, client ReadClient, opts Cac
 		for handlerType, handler := range c.handlers {
 			group.Go(func() error {
 				if handler.isEnabled() {
-					return handler.init()
+					return handler.init(ctx)
 				}
 
-				log.Printf("[TWINGATE_LOG] cache init for type %v: skipped.", handlerType)
+				logInfo(ctx, "Cache init skipped", map[string]any{logFieldCacheType: handlerType})
 
 				return nil
 			})
 		}
 
 		if err := group.Wait(); err != nil {
-			log.Printf("[TWINGATE_LOG] [ERR] cache init failed: %s", err.Error())
+			logError(ctx, "Cache init failed", map[string]any{logFieldError: err.Error()})
 		}
 	})
 }
@@ -87,9 +87,9 @@ func (c *clientCache) setClient(ctx context.Context, client ReadClient, opts Cac
 type resourceHandler interface {
 	isEnabled() bool
 	isFilterSet() bool
-	init() error
-	getResource(resourceID string) (any, bool)
-	setResource(resource identifiable)
+	init(ctx context.Context) error
+	getResource(ctx context.Context, resourceID string) (any, bool)
+	setResource(ctx context.Context, resource identifiable)
 	invalidateResource(resourceID string)
 	matchResources(filter model.ResourceFilter) []any
 }
@@ -119,7 +119,7 @@ func (h *handler[T, F]) isFilterSet() bool {
 	return !isNil(h.filter)
 }
 
-func (h *handler[T, F]) getResource(resourceID string) (any, bool) {
+func (h *handler[T, F]) getResource(ctx context.Context, resourceID string) (any, bool) {
 	var emptyObj T
 
 	if h.readResources == nil {
@@ -134,7 +134,7 @@ func (h *handler[T, F]) getResource(resourceID string) (any, bool) {
 
 	obj, err := copystructure.Copy(res)
 	if err != nil {
-		log.Printf("[TWINGATE_LOG] [ERR] %T failed copy object from cache: %s", emptyObj, err.Error())
+		logError(ctx, "Failed to copy the object from the cache", map[string]any{logFieldCacheType: fmt.Sprintf("%T", emptyObj), logFieldError: err.Error()})
 
 		return emptyObj, false
 	}
@@ -157,20 +157,20 @@ func (h *handler[T, F]) matchResources(filter model.ResourceFilter) []any {
 	return matched
 }
 
-func (h *handler[T, F]) setResource(resource identifiable) {
+func (h *handler[T, F]) setResource(ctx context.Context, resource identifiable) {
 	if resource == nil {
 		return
 	}
 
 	defer func() {
 		if r := recover(); r != nil {
-			log.Printf("[TWINGATE_LOG] [ERR] setResource failed: %v", r)
+			logError(ctx, "Failed to store the object to the cache", map[string]any{logFieldError: fmt.Sprint(r)})
 		}
 	}()
 
 	obj, err := copystructure.Copy(resource)
 	if err != nil {
-		log.Printf("[TWINGATE_LOG] [ERR] %T failed store object to cache: %s", resource, err.Error())
+		logError(ctx, "Failed to store the object to the cache", map[string]any{logFieldCacheType: fmt.Sprintf("%T", resource), logFieldError: err.Error()})
 
 		return
 	}
@@ -178,9 +178,9 @@ func (h *handler[T, F]) setResource(resource identifiable) {
 	h.resources.Store(resource.GetID(), obj)
 }
 
-func (h *handler[T, F]) setResources(resources []T) {
+func (h *handler[T, F]) setResources(ctx context.Context, resources []T) {
 	for _, resource := range resources {
-		h.setResource(resource)
+		h.setResource(ctx, resource)
 	}
 }
 
@@ -188,7 +188,7 @@ func (h *handler[T, F]) invalidateResource(id string) {
 	h.resources.Delete(id)
 }
 
-func (h *handler[T, F]) init() error {
+func (h *handler[T, F]) init(ctx context.Context) error {
 	var initErr error
 
 	h.once.Do(func() {
@@ -198,49 +198,49 @@ func (h *handler[T, F]) init() error {
 			resources []T
 		)
 
-		log.Printf("[TWINGATE_LOG] cache init for type %T: started. Filter set: %v.", res, !isNil(h.filter))
+		logInfo(ctx, "Cache init started", map[string]any{logFieldCacheType: fmt.Sprintf("%T", res), "filter_set": !isNil(h.filter)})
 
 		if isNil(h.filter) {
 			// read all resources
-			resources, err = h.readResources(WithCallerCtx(context.Background(), cacheKey))
+			resources, err = h.readResources(WithCallerCtx(ctx, cacheKey))
 		} else {
-			log.Printf("[TWINGATE_LOG] cache init for type %T: started. Applying filter: %v.", res, h.filter)
+			logDebug(ctx, "Cache init applies the filter", map[string]any{logFieldCacheType: fmt.Sprintf("%T", res), "filter": fmt.Sprintf("%v", h.filter)})
 
 			// read filtered resources
-			resources, err = h.filterResources(WithCallerCtx(context.Background(), cacheKey), h.filter)
+			resources, err = h.filterResources(WithCallerCtx(ctx, cacheKey), h.filter)
 		}
 
 		if err != nil {
-			log.Printf("[TWINGATE_LOG] [ERR] cache init for type %T failed: %s", res, err.Error())
+			logError(ctx, "Cache init failed", map[string]any{logFieldCacheType: fmt.Sprintf("%T", res), logFieldError: err.Error()})
 
 			initErr = err
 
 			return
 		}
 
-		h.setResources(resources)
+		h.setResources(ctx, resources)
 
-		log.Printf("[TWINGATE_LOG] cache init for type %T: finished.", res)
+		logInfo(ctx, "Cache init finished", map[string]any{logFieldCacheType: fmt.Sprintf("%T", res), "count": len(resources)})
 	})
 
 	return initErr
 }
 
-func getResource[T any](resourceID string) (T, bool) {
+func getResource[T any](ctx context.Context, resourceID string) (T, bool) {
 	var (
 		res    T
 		exists bool
 	)
 
 	handle(res, func(handler resourceHandler) {
-		resource, found := handler.getResource(resourceID)
+		resource, found := handler.getResource(ctx, resourceID)
 		if !found || resource == nil {
 			return
 		}
 
 		obj, ok := resource.(T)
 		if !ok {
-			log.Printf("[TWINGATE_LOG] [ERR] getResource failed: expected type %T, got %T", res, resource)
+			logError(ctx, "Failed to get the object from the cache", map[string]any{logFieldCacheType: fmt.Sprintf("%T", res), logFieldError: fmt.Sprintf("unexpected type %T", resource)})
 
 			return
 		}
@@ -252,9 +252,9 @@ func getResource[T any](resourceID string) (T, bool) {
 	return res, exists
 }
 
-func setResource(resource identifiable) {
+func setResource(ctx context.Context, resource identifiable) {
 	handle(resource, func(handler resourceHandler) {
-		handler.setResource(resource)
+		handler.setResource(ctx, resource)
 	})
 }
 
@@ -276,7 +276,7 @@ func handlerKey(handlerType any) string {
 	return reflect.TypeOf(handlerType).String()
 }
 
-func matchResources[T any](filter model.ResourceFilter) []T {
+func matchResources[T any](ctx context.Context, filter model.ResourceFilter) []T {
 	var (
 		res     T
 		matched []T
@@ -287,7 +287,7 @@ func matchResources[T any](filter model.ResourceFilter) []T {
 		for _, resource := range resources {
 			obj, ok := resource.(T)
 			if !ok {
-				log.Printf("[TWINGATE_LOG] [ERR] matchResources failed: expected type %T, got %T", res, resource)
+				logError(ctx, "Failed to match the objects in the cache", map[string]any{logFieldCacheType: fmt.Sprintf("%T", res), logFieldError: fmt.Sprintf("unexpected type %T", resource)})
 
 				return
 			}
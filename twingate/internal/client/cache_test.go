@@ -56,9 +56,9 @@ func TestHandler_SetAndGetResource(t *testing.T) {
 		},
 	}
 
-	handler.setResource(mockResource)
+	handler.setResource(t.Context(), mockResource)
 
-	result, exists := handler.getResource("resource1")
+	result, exists := handler.getResource(t.Context(), "resource1")
 	assert.True(t, exists)
 
 	retrievedResource, ok := result.(*model.Resource)
@@ -76,12 +76,12 @@ func TestHandler_InvalidateResource(t *testing.T) {
 		},
 	}
 
-	handler.setResource(mockResource)
-	_, existsBefore := handler.getResource("resource2")
+	handler.setResource(t.Context(), mockResource)
+	_, existsBefore := handler.getResource(t.Context(), "resource2")
 	assert.True(t, existsBefore)
 
 	handler.invalidateResource("resource2")
-	_, existsAfter := handler.getResource("resource2")
+	_, existsAfter := handler.getResource(t.Context(), "resource2")
 	assert.False(t, existsAfter)
 }
 
@@ -97,11 +97,11 @@ func TestHandler_Init(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
-	_, exists1 := handler.getResource("resource1")
-	_, exists2 := handler.getResource("resource2")
+	_, exists1 := handler.getResource(t.Context(), "resource1")
+	_, exists2 := handler.getResource(t.Context(), "resource2")
 	assert.True(t, exists1)
 	assert.True(t, exists2)
 }
@@ -118,7 +118,7 @@ func TestHandler_MatchGroupsByName(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.GroupsFilter{
@@ -145,7 +145,7 @@ func TestHandler_MatchGroupsByNameAndIsActive(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	isActive := false
@@ -175,7 +175,7 @@ func TestHandler_MatchGroupsByNameAndType(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.GroupsFilter{
@@ -203,7 +203,7 @@ func TestHandler_MatchGroupsByNameAndTypeAndIsActive(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	isActive := true
@@ -233,7 +233,7 @@ func TestHandler_MatchGroupsByTypeIsActiveNamePrefix(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	isActive := true
@@ -264,7 +264,7 @@ func TestHandler_MatchGroupsByTypeIsActiveNameSuffix(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	isActive := true
@@ -295,7 +295,7 @@ func TestHandler_MatchGroupsByTypeIsActiveNameContains(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	isActive := false
@@ -326,7 +326,7 @@ func TestHandler_MatchGroupsByTypeNameExclude(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.GroupsFilter{
@@ -356,7 +356,7 @@ func TestHandler_MatchGroupsByTypeNameRegexp(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.GroupsFilter{
@@ -386,7 +386,7 @@ func TestHandler_MatchGroupsByTypeNameInvalidRegexp(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.GroupsFilter{
@@ -410,7 +410,7 @@ func TestHandler_MatchResourcesByName(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -437,7 +437,7 @@ func TestHandler_MatchResourcesByNameAndTag(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -465,7 +465,7 @@ func TestHandler_MatchResourceByNamePrefix(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -494,7 +494,7 @@ func TestHandler_MatchResourceByNameSuffix(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -523,7 +523,7 @@ func TestHandler_MatchResourceByNameContains(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -553,7 +553,7 @@ func TestHandler_MatchResourceByNameExclude(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -583,7 +583,7 @@ func TestHandler_MatchResourceByNameRegexp(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -613,7 +613,7 @@ func TestHandler_MatchResourceByNameInvalidRegexp(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -650,7 +650,7 @@ func TestHandler_MatchResourceByNameWithFilter(t *testing.T) {
 		},
 	}
 
-	err := handler.init()
+	err := handler.init(t.Context())
 	assert.NoError(t, err)
 
 	matched := handler.matchResources(&model.ResourcesFilter{
@@ -7,18 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hasura/go-graphql-client"
+	"go.opentelemetry.io/otel"
 )
 
 const (
@@ -31,14 +32,7 @@ const (
 	headerAgent         = "User-Agent"
 	headerCorrelationID = "X-Correlation-Id"
 	headerRequestID     = "X-Twingate-Request-Id"
-
-	defaultPageLimit  = 50
-	extendedPageLimit = 100
-
-	defaultRateLimit = 3
-
-	defaultQueryTimeout = time.Second * 10
-	defaultQueryRetries = 3
+	headerIdempotency   = "Idempotency-Key"
 )
 
 var (
@@ -60,8 +54,11 @@ type Client struct {
 	agent            string
 	version          string
 	pageLimit        int
+	options          OperationOptions
 	correlationID    string
 	ratelimiter      chan struct{}
+	telemetry        *telemetry
+	resourceReads    *coalescer[*model.Resource]
 }
 
 type transport struct {
@@ -80,6 +77,10 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set(headerAgent, t.version)
 	req.Header.Set(headerCorrelationID, t.correlationID)
 
+	if key := getIdempotencyKeyFromCtx(req.Context()); key != "" {
+		req.Header.Set(headerIdempotency, key)
+	}
+
 	return t.underlineRoundTripper.RoundTrip(req) //nolint:wrapcheck
 }
 
@@ -133,11 +134,11 @@ func customRetryPolicy(ctx context.Context, resp *http.Response, err error) (boo
 	}
 
 	if err != nil {
-		log.Printf("[TWINGATE_LOG] [WARN] [RETRY_POLICY] [id:%s] error: %s", reqID, err.Error())
+		logWarn(ctx, "Request failed", map[string]any{logFieldRequestID: reqID, logFieldError: err.Error()})
 	}
 
 	if ctx.Err() != nil {
-		log.Printf("[TWINGATE_LOG] [WARN] [RETRY_POLICY] [id:%s] context error: %s", reqID, ctx.Err().Error())
+		logWarn(ctx, "Request context is done", map[string]any{logFieldRequestID: reqID, logFieldError: ctx.Err().Error()})
 	}
 
 	// do not retry if API token not set
@@ -146,13 +147,8 @@ func customRetryPolicy(ctx context.Context, resp *http.Response, err error) (boo
 	}
 
 	// do not retry if there is an issue with TLS certificate
-	if err != nil {
-		if v, ok := err.(*url.Error); ok { //nolint:errorlint
-			if certNameNotMatchMacErrorRe.MatchString(v.Error()) ||
-				certNameNotMatchLinuxErrorRe.MatchString(v.Error()) {
-				return false, v
-			}
-		}
+	if tlsErr := tlsCertificateError(err); tlsErr != nil {
+		return false, tlsErr
 	}
 
 	shouldRetry, resultErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
@@ -160,14 +156,28 @@ func customRetryPolicy(ctx context.Context, resp *http.Response, err error) (boo
 		return false, resultErr //nolint
 	}
 
+	// a create may have been processed, it is retried by the operation after looking up the created object
+	if getIdempotencyKeyFromCtx(ctx) != "" && !isRejectedRequest(resp) {
+		return false, nil
+	}
+
+	// the operation used its retry budget, the last response or error is returned
+	if !takeRetry(ctx) {
+		return false, nil
+	}
+
 	if resp != nil {
-		log.Printf("[TWINGATE_LOG] [WARN] [RETRY_POLICY] [id:%s] going to retry call %s, status %s", reqID, resp.Request.URL.String(), resp.Status)
+		fields := map[string]any{
+			logFieldRequestID: reqID,
+			logFieldURL:       SafeURL(resp.Request.URL.String()),
+			logFieldStatus:    resp.StatusCode,
+		}
 
 		if resp.Request.GetBody != nil {
 			reqBody, _ := resp.Request.GetBody()
 			if reqBody != nil {
 				reqBodyBytes, _ := io.ReadAll(reqBody)
-				log.Printf("[TWINGATE_LOG] [WARN] [RETRY_POLICY] [id:%s] request: %s", reqID, string(reqBodyBytes))
+				fields[logFieldRequestBody] = redactBody(reqBodyBytes)
 			}
 		}
 
@@ -175,42 +185,80 @@ func customRetryPolicy(ctx context.Context, resp *http.Response, err error) (boo
 			body, bodyErr := io.ReadAll(resp.Body)
 			if bodyErr == nil {
 				resp.Body = io.NopCloser(bytes.NewBuffer(body))
-				log.Printf("[TWINGATE_LOG] [WARN] [RETRY_POLICY] [id:%s] response: %s", reqID, string(body))
+				fields[logFieldResponseBody] = redactBody(body)
 			}
 		}
+
+		logWarn(ctx, "Going to retry the request", fields)
 	}
 
 	return true, nil
 }
 
-func NewCustomRetryableClient(httpTimeout time.Duration, httpRetryMax int, apiToken, agent, version, correlationID string) *http.Client {
+// NewCustomRetryableClient returns the retrying HTTP client of the API. The httpTransport is
+// built with NewHTTPTransport, when nil the default pooled transport is used.
+func NewCustomRetryableClient(httpTimeout time.Duration, httpRetryMax int, httpTransport http.RoundTripper, apiToken, agent, version, correlationID string) *http.Client {
+	transport := newTransport(withDefaultTransport(httpTransport), apiToken, agent, version, correlationID)
+
+	return newRetryableClient(DefaultRetryOptions(httpTimeout, httpRetryMax), transport).StandardClient()
+}
+
+func newRetryableClient(opts RetryOptions, httpTransport http.RoundTripper) *retryablehttp.Client {
 	retryableClient := retryablehttp.NewClient()
 	retryableClient.Logger = nil
 	retryableClient.CheckRetry = customRetryPolicy
-	retryableClient.RetryMax = httpRetryMax
+	retryableClient.RetryMax = opts.MaxRetries
+	retryableClient.RetryWaitMin = opts.RetryWaitMin
+	retryableClient.RetryWaitMax = opts.RetryWaitMax
 	retryableClient.RequestLogHook = func(logger retryablehttp.Logger, req *http.Request, retryNumber int) {
 		reqID, _ := uuid.GenerateUUID()
 		req.Header.Set(headerRequestID, reqID)
 
 		if retryNumber > 0 {
-			log.Printf("[TWINGATE_LOG] [WARN] [id:%s] Failed to call %s (retry %d)", reqID, SafeURL(req.URL.String()), retryNumber) // #nosec G706
+			recordHTTPRetry(req, retryNumber)
+			logWarn(req.Context(), "Retrying the request", map[string]any{
+				logFieldRequestID: reqID,
+				logFieldURL:       SafeURL(req.URL.String()),
+				logFieldRetry:     retryNumber,
+			})
 		}
 	}
-	retryableClient.HTTPClient.Timeout = httpTimeout
-	retryableClient.HTTPClient.Transport = newTransport(retryableClient.HTTPClient.Transport, apiToken, agent, version, correlationID)
+	// pass the last response through when retries are exhausted, so that its status classifies the error
+	retryableClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	retryableClient.HTTPClient.Timeout = opts.Timeout
+	retryableClient.HTTPClient.Transport = httpTransport
+
+	return retryableClient
+}
+
+func withDefaultTransport(httpTransport http.RoundTripper) http.RoundTripper {
+	if httpTransport == nil {
+		return cleanhttp.DefaultPooledTransport()
+	}
 
-	return retryableClient.StandardClient()
+	return httpTransport
 }
 
 func SafeURL(url string) string {
 	return strings.NewReplacer("\n", "", "\r", "").Replace(url)
 }
 
-func NewClient(ctx context.Context, regionalURL, apiToken string, httpTimeout time.Duration, httpRetryMax int, agent, version string, opts CacheOptions) *Client {
+// NewClient returns the API client. The operation options set the timeouts and retries of the queries,
+// mutations and pagination requests, see DefaultOperationOptions.
+func NewClient(ctx context.Context, regionalURL, apiToken string, operationOpts OperationOptions, httpTransport http.RoundTripper, agent, version string, opts CacheOptions) *Client {
 	correlationID, _ := uuid.GenerateUUID()
 
 	sURL := newServerURL(regionalURL)
-	httpClient := NewCustomRetryableClient(httpTimeout, httpRetryMax, apiToken, agent, version, correlationID)
+	transport := newTransport(withDefaultTransport(httpTransport), apiToken, agent, version, correlationID)
+	httpClient := &http.Client{Transport: newOperationTransport(operationOpts, transport)}
+
+	if operationOpts.PageSize <= 0 {
+		operationOpts.PageSize = DefaultPageSize
+	}
+
+	if operationOpts.MaxConcurrentMutations <= 0 {
+		operationOpts.MaxConcurrentMutations = DefaultMaxConcurrentMutations
+	}
 
 	client := Client{
 		HTTPClient:       httpClient,
@@ -221,12 +269,16 @@ func NewClient(ctx context.Context, regionalURL, apiToken string, httpTimeout ti
 		}),
 		agent:         agent,
 		version:       version,
-		pageLimit:     getPageLimit(),
+		pageLimit:     operationOpts.PageSize,
+		options:       operationOpts,
 		correlationID: correlationID,
-		ratelimiter:   make(chan struct{}, getRateLimit()),
+		ratelimiter:   make(chan struct{}, operationOpts.MaxConcurrentMutations),
+		telemetry:     newTelemetry(otel.GetTracerProvider(), otel.GetMeterProvider(), correlationID),
 	}
 
-	log.Printf("[TWINGATE_LOG] [INFO] Using Server URL %s", sURL.newGraphqlServerURL())
+	client.resourceReads = newCoalescer(getReadBatchWindow(), maxReadBatchSize, client.readResource, client.readResourcesBatch)
+
+	logInfo(ctx, "Using Server URL", map[string]any{logFieldURL: sURL.newGraphqlServerURL(), logFieldCorrelationID: correlationID})
 
 	if opts.GroupsEnabled || opts.ResourceEnabled {
 		cache.setClient(ctx, &client, opts)
@@ -235,28 +287,6 @@ func NewClient(ctx context.Context, regionalURL, apiToken string, httpTimeout ti
 	return &client
 }
 
-func getPageLimit() int {
-	str := os.Getenv(EnvPageLimit)
-
-	val, err := strconv.Atoi(str)
-	if err != nil {
-		return defaultPageLimit
-	}
-
-	return val
-}
-
-func getRateLimit() int {
-	str := os.Getenv(EnvRateLimit)
-
-	val, err := strconv.Atoi(str)
-	if err != nil {
-		return defaultRateLimit
-	}
-
-	return val
-}
-
 func (client *Client) post(ctx context.Context, url string, payload any, headers map[string]string) ([]byte, error) {
 	var body io.Reader
 
@@ -292,6 +322,8 @@ func (client *Client) doRequest(req *http.Request) ([]byte, error) {
 		return nil, fmt.Errorf("%w: %q", ErrDisallowedHost, req.URL.Host)
 	}
 
+	req = req.WithContext(withLogger(req.Context()))
+
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set(headerAgent, twingateAgentVersion(client.agent, client.version))
 	req.Header.Set(headerCorrelationID, client.correlationID)
@@ -303,7 +335,7 @@ func (client *Client) doRequest(req *http.Request) ([]byte, error) {
 
 	defer func(closer io.Closer) {
 		if err := closer.Close(); err != nil {
-			log.Printf("[TWINGATE_LOG] [ERR] Error Closing: %s", err)
+			logError(req.Context(), "Failed to close the response body", map[string]any{logFieldError: err.Error()})
 		}
 	}(res.Body)
 
@@ -329,18 +361,31 @@ func (client *Client) release() {
 	<-client.ratelimiter
 }
 
-func (client *Client) lock() {
+// lock blocks until the rate limiter allows a request and returns the time it waited.
+func (client *Client) lock() time.Duration {
+	start := time.Now()
 	client.ratelimiter <- struct{}{}
+
+	return time.Since(start)
 }
 
-func (client *Client) mutate(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) error {
-	client.lock()
+func (client *Client) mutate(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) (err error) {
+	start := time.Now()
+	ctx = withOperationClassCtx(ctx, operationClassMutation)
+	ctx = withRetryBudget(ctx, client.options.Mutation.MaxRetries)
+	oprName := concatOperations(getCallerFromCtx(ctx), getOperationFromCtx(ctx), opr.String())
+
+	ctx, oprAttrs := client.telemetry.startOperation(withOperationLogger(ctx, oprName), oprName, opr, attrs...)
+	defer func() {
+		client.telemetry.endOperation(ctx, oprAttrs, start, err)
+	}()
+
+	client.telemetry.recordRateLimiterWait(ctx, oprAttrs, client.lock())
 	defer client.release()
 
-	caller := getCallerFromCtx(ctx)
-	parentOpr := getOperationFromCtx(ctx)
+	logDebug(ctx, "Sending GraphQL mutate")
 
-	err := client.GraphqlClient.Mutate(ctx, resp, variables, graphql.OperationName(concatOperations(caller, parentOpr, opr.String())))
+	err = client.GraphqlClient.Mutate(ctx, resp, variables, graphql.OperationName(oprName))
 	if err != nil {
 		return opr.apiError(err, attrs...)
 	}
@@ -359,8 +404,21 @@ func (client *Client) mutate(ctx context.Context, resp MutationResponse, variabl
 func (client *Client) mutateWithTimeout(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, attrs ...attr) error {
 	var err error
 
-	for i := 0; i == 0 || err != nil && (strings.Contains(err.Error(), "timeout") || strings.Contains(err.Error(), "deadline")) && i < defaultQueryRetries; i++ {
-		timeoutCtx, cancel := context.WithTimeout(ctx, defaultQueryTimeout)
+	opts := client.options.Mutation
+	// the retries of the requests and of the operation share the budget
+	ctx = withRetryBudget(ctx, opts.MaxRetries)
+
+	for i := 0; i == 0 || shouldRetryQuery(ctx, err) && takeRetry(ctx); i++ {
+		if i > 0 {
+			client.telemetry.recordQueryRetry(ctx, opr)
+			logWarn(ctx, "Retrying the operation", map[string]any{
+				logFieldOperation: opr.String(),
+				logFieldAttempt:   i + 1,
+				logFieldError:     err.Error(),
+			})
+		}
+
+		timeoutCtx, cancel := withTimeout(withAttemptCtx(ctx, i+1), opts.Timeout)
 		err = client.mutate(timeoutCtx, resp, variables, opr, attrs...)
 
 		cancel()
@@ -369,6 +427,20 @@ func (client *Client) mutateWithTimeout(ctx context.Context, resp MutationRespon
 	return err
 }
 
+// withTimeout limits the context unless the timeout is not set.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// shouldRetryQuery retries transient errors, e.g. timeouts, unless the parent context is done.
+func shouldRetryQuery(ctx context.Context, err error) bool {
+	return err != nil && ctx.Err() == nil && errors.Is(err, ErrTransient)
+}
+
 type ResponseWithPayload interface {
 	IsEmpty() bool
 }
@@ -376,8 +448,21 @@ type ResponseWithPayload interface {
 func (client *Client) queryWithTimeout(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) error {
 	var err error
 
-	for i := 0; i == 0 || err != nil && (strings.Contains(err.Error(), "timeout") || strings.Contains(err.Error(), "deadline")) && i < defaultQueryRetries; i++ {
-		timeoutCtx, cancel := context.WithTimeout(ctx, defaultQueryTimeout)
+	opts := client.options.forClass(queryClass(ctx))
+	// the retries of the requests and of the operation share the budget
+	ctx = withRetryBudget(ctx, opts.MaxRetries)
+
+	for i := 0; i == 0 || shouldRetryQuery(ctx, err) && takeRetry(ctx); i++ {
+		if i > 0 {
+			client.telemetry.recordQueryRetry(ctx, opr)
+			logWarn(ctx, "Retrying the operation", map[string]any{
+				logFieldOperation: opr.String(),
+				logFieldAttempt:   i + 1,
+				logFieldError:     err.Error(),
+			})
+		}
+
+		timeoutCtx, cancel := withTimeout(withAttemptCtx(ctx, i+1), opts.Timeout)
 		err = client.query(timeoutCtx, resp, variables, opr, attrs...)
 
 		cancel()
@@ -386,14 +471,24 @@ func (client *Client) queryWithTimeout(ctx context.Context, resp ResponseWithPay
 	return err
 }
 
-func (client *Client) query(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) error {
-	client.lock()
+func (client *Client) query(ctx context.Context, resp ResponseWithPayload, variables map[string]any, opr operation, attrs ...attr) (err error) {
+	start := time.Now()
+	class := queryClass(ctx)
+	ctx = withOperationClassCtx(ctx, class)
+	ctx = withRetryBudget(ctx, client.options.forClass(class).MaxRetries)
+	oprName := concatOperations(getCallerFromCtx(ctx), getOperationFromCtx(ctx), opr.String())
+
+	ctx, oprAttrs := client.telemetry.startOperation(withOperationLogger(ctx, oprName), oprName, opr, attrs...)
+	defer func() {
+		client.telemetry.endOperation(ctx, oprAttrs, start, err)
+	}()
+
+	client.telemetry.recordRateLimiterWait(ctx, oprAttrs, client.lock())
 	defer client.release()
 
-	caller := getCallerFromCtx(ctx)
-	parentOpr := getOperationFromCtx(ctx)
+	logDebug(ctx, "Sending GraphQL query")
 
-	err := client.GraphqlClient.Query(ctx, resp, variables, graphql.OperationName(concatOperations(caller, parentOpr, opr.String())))
+	err = client.GraphqlClient.Query(ctx, resp, variables, graphql.OperationName(oprName))
 	if err != nil {
 		return opr.apiError(err, attrs...)
 	}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
@@ -14,6 +13,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,7 +21,7 @@ import (
 func newTestClient(ctx context.Context) *Client {
 	return NewClient(ctx,
 		"https://test.twindev.com", "xxxx",
-		time.Duration(1)*time.Second, 0, DefaultAgent, "test", skipCache,
+		DefaultOperationOptions(time.Second, 0), nil, DefaultAgent, "test", skipCache,
 	)
 }
 
@@ -83,7 +83,7 @@ func TestClientAPITokenNotSet(t *testing.T) {
 
 	client := NewClient(t.Context(),
 		"https://test.twindev.com", "",
-		time.Duration(1)*time.Second, 0, DefaultAgent, "test", skipCache,
+		DefaultOperationOptions(time.Second, 0), nil, DefaultAgent, "test", skipCache,
 	)
 
 	_, err := client.post(context.TODO(), "/hello", "hello", nil)
@@ -99,10 +99,10 @@ func TestClientAPITokenNotSet(t *testing.T) {
 func TestClientInvalidServerAddress(t *testing.T) {
 	client := NewClient(t.Context(),
 		"https://beamreach.twingate.com", "XXXXX",
-		time.Duration(10)*time.Second, 3, DefaultAgent, "test", skipCache,
+		DefaultOperationOptions(10*time.Second, 3), nil, DefaultAgent, "test", skipCache,
 	)
 
-	internal := client.HTTPClient.Transport.(*retryablehttp.RoundTripper)
+	internal := client.HTTPClient.Transport.(*operationTransport).clients[operationClassQuery]
 	internal.Client.RequestLogHook = func(logger retryablehttp.Logger, req *http.Request, retryNumber int) {
 		assert.Less(t, retryNumber, 3)
 	}
@@ -147,7 +147,8 @@ func TestCustomRetryPolicy(t *testing.T) {
 		shouldRetry, err := customRetryPolicy(ctx, resp, fakeURLError)
 
 		assert.False(t, shouldRetry)
-		assert.Equal(t, fakeURLError, err)
+		assert.ErrorIs(t, err, ErrTLSCertificate)
+		assert.ErrorIs(t, err, fakeURLError)
 	})
 
 	t.Run("Retry enabled on other errors", func(t *testing.T) {
@@ -229,9 +230,12 @@ func TestCustomRetryPolicy(t *testing.T) {
 }
 
 func TestCustomRetryPolicy_RequestBodyLogging(t *testing.T) {
-	ctx := context.Background()
+	// Capture logs using a bytes buffer to verify log output
+	var logBuffer bytes.Buffer
 
-	requestBody := `{"key":"value"}`
+	ctx := tflogtest.RootLogger(context.Background(), &logBuffer)
+
+	requestBody := `{"key":"value","email":"user@example.com"}`
 	mockRequest := &http.Request{
 		Method: "POST",
 		URL:    &url.URL{Path: "/test/path"},
@@ -246,15 +250,17 @@ func TestCustomRetryPolicy_RequestBodyLogging(t *testing.T) {
 		Request: mockRequest,
 	}
 
-	// Capture logs using a bytes buffer to verify log output
-	var logBuffer bytes.Buffer
-	log.SetOutput(&logBuffer)
-	defer log.SetOutput(io.Discard) // Reset the logger after the test
-
 	_, err := customRetryPolicy(ctx, mockResponse, io.EOF)
 	assert.NoError(t, err)
 
 	// Validate logs
-	logOutput := logBuffer.String()
-	assert.Contains(t, logOutput, "[WARN] [RETRY_POLICY] [id:test_id] request: "+requestBody)
+	entries, err := tflogtest.MultilineJSONDecode(&logBuffer)
+	assert.NoError(t, err)
+
+	retryEntry := entries[len(entries)-1]
+	assert.Equal(t, "Going to retry the request", retryEntry["@message"])
+	assert.Equal(t, "provider.client", retryEntry["@module"])
+	assert.Equal(t, "warn", retryEntry["@level"])
+	assert.Equal(t, "test_id", retryEntry[logFieldRequestID])
+	assert.Equal(t, `{"key":"value","email":"***"}`, retryEntry[logFieldRequestBody])
 }
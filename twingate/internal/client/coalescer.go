@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// EnvReadBatchWindow sets the window in milliseconds in which concurrent reads are batched, 0 disables batching.
+	EnvReadBatchWindow = "TWINGATE_READ_BATCH_WINDOW"
+
+	defaultReadBatchWindow = 10 * time.Millisecond
+	maxReadBatchSize       = 50
+)
+
+type coalescedResult[T any] struct {
+	value T
+	err   error
+}
+
+type coalescedRead[T any] struct {
+	ctx    context.Context //nolint:containedctx
+	id     string
+	result chan coalescedResult[T]
+}
+
+// readOneFunc reads a single object.
+type readOneFunc[T any] func(ctx context.Context, id string) (T, error)
+
+// readBatchFunc reads several objects in one request. It returns the objects by the index of their ID,
+// the missing ones are read with single reads, e.g. after partial errors.
+type readBatchFunc[T any] func(ctx context.Context, ids []string) (map[int]T, error)
+
+// coalescer collects the concurrent reads of single objects within a short window and issues them
+// as one batch read, then fans the results back to the callers.
+type coalescer[T any] struct {
+	window    time.Duration
+	maxSize   int
+	readOne   readOneFunc[T]
+	readBatch readBatchFunc[T]
+
+	mu      sync.Mutex
+	pending []*coalescedRead[T]
+	timer   *time.Timer
+}
+
+func newCoalescer[T any](window time.Duration, maxSize int, readOne readOneFunc[T], readBatch readBatchFunc[T]) *coalescer[T] {
+	return &coalescer[T]{
+		window:    window,
+		maxSize:   maxSize,
+		readOne:   readOne,
+		readBatch: readBatch,
+	}
+}
+
+func (c *coalescer[T]) read(ctx context.Context, id string) (T, error) {
+	if c.window <= 0 || c.maxSize <= 1 {
+		return c.readOne(ctx, id)
+	}
+
+	req := &coalescedRead[T]{
+		ctx:    ctx,
+		id:     id,
+		result: make(chan coalescedResult[T], 1),
+	}
+
+	c.mu.Lock()
+
+	c.pending = append(c.pending, req)
+
+	switch {
+	case len(c.pending) >= c.maxSize:
+		go c.execute(c.takePending())
+	case len(c.pending) == 1:
+		c.timer = time.AfterFunc(c.window, c.flush)
+	}
+
+	c.mu.Unlock()
+
+	select {
+	case res := <-req.result:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero T
+
+		return zero, ctx.Err()
+	}
+}
+
+// takePending must be called with the lock held.
+func (c *coalescer[T]) takePending() []*coalescedRead[T] {
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+
+	batch := c.pending
+	c.pending = nil
+
+	return batch
+}
+
+func (c *coalescer[T]) flush() {
+	c.mu.Lock()
+	batch := c.takePending()
+	c.mu.Unlock()
+
+	c.execute(batch)
+}
+
+func (c *coalescer[T]) execute(batch []*coalescedRead[T]) {
+	if len(batch) == 0 {
+		return
+	}
+
+	if len(batch) == 1 {
+		c.fallback(batch[0])
+
+		return
+	}
+
+	ids := make([]string, 0, len(batch))
+	for _, req := range batch {
+		ids = append(ids, req.id)
+	}
+
+	// the batch must not fail when the caller who started it is cancelled
+	ctx := context.WithoutCancel(batch[0].ctx)
+
+	values, err := c.readBatch(ctx, ids)
+	if err != nil {
+		logDebug(ctx, "Batch read failed: falling back to single reads", map[string]any{
+			logFieldError: err.Error(),
+			"count":       len(batch) - len(values),
+		})
+	}
+
+	for i, req := range batch {
+		if value, ok := values[i]; ok {
+			req.result <- coalescedResult[T]{value: value}
+
+			continue
+		}
+
+		go c.fallback(req)
+	}
+}
+
+func (c *coalescer[T]) fallback(req *coalescedRead[T]) {
+	value, err := c.readOne(req.ctx, req.id)
+	req.result <- coalescedResult[T]{value: value, err: err}
+}
+
+func getReadBatchWindow() time.Duration {
+	str, ok := os.LookupEnv(EnvReadBatchWindow)
+	if !ok {
+		return defaultReadBatchWindow
+	}
+
+	val, err := strconv.Atoi(str)
+	if err != nil {
+		return defaultReadBatchWindow
+	}
+
+	return time.Duration(val) * time.Millisecond
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeReads struct {
+	mu      sync.Mutex
+	single  []string
+	batches [][]string
+	missing map[string]bool
+	err     error
+}
+
+func (f *fakeReads) readOne(ctx context.Context, id string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.single = append(f.single, id)
+
+	if f.missing[id] {
+		return "", ErrGraphqlResultIsEmpty
+	}
+
+	return "value-" + id, nil
+}
+
+func (f *fakeReads) readBatch(ctx context.Context, ids []string) (map[int]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.batches = append(f.batches, ids)
+
+	values := make(map[int]string, len(ids))
+
+	for i, id := range ids {
+		if !f.missing[id] {
+			values[i] = "value-" + id
+		}
+	}
+
+	return values, f.err
+}
+
+func readConcurrently(c *coalescer[string], ids ...string) ([]string, []error) {
+	values := make([]string, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Go(func() {
+			values[i], errs[i] = c.read(context.Background(), id)
+		})
+	}
+
+	wg.Wait()
+
+	return values, errs
+}
+
+func TestCoalescerBatchesConcurrentReads(t *testing.T) {
+	reads := &fakeReads{}
+	c := newCoalescer(50*time.Millisecond, 10, reads.readOne, reads.readBatch)
+
+	values, errs := readConcurrently(c, "1", "2", "3")
+
+	assert.Equal(t, []string{"value-1", "value-2", "value-3"}, values)
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	assert.Len(t, reads.batches, 1)
+	assert.ElementsMatch(t, []string{"1", "2", "3"}, reads.batches[0])
+	assert.Empty(t, reads.single)
+}
+
+func TestCoalescerSingleReadIsNotBatched(t *testing.T) {
+	reads := &fakeReads{}
+	c := newCoalescer(time.Millisecond, 10, reads.readOne, reads.readBatch)
+
+	value, err := c.read(context.Background(), "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "value-1", value)
+	assert.Empty(t, reads.batches)
+	assert.Equal(t, []string{"1"}, reads.single)
+}
+
+func TestCoalescerFlushesFullBatch(t *testing.T) {
+	reads := &fakeReads{}
+	// the window never ends, only full batches are flushed
+	c := newCoalescer(time.Hour, 2, reads.readOne, reads.readBatch)
+
+	values, errs := readConcurrently(c, "1", "2", "3", "4")
+
+	assert.ElementsMatch(t, []string{"value-1", "value-2", "value-3", "value-4"}, values)
+	assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+	assert.Len(t, reads.batches, 2)
+}
+
+func TestCoalescerFallsBackToSingleReads(t *testing.T) {
+	reads := &fakeReads{
+		missing: map[string]bool{"2": true},
+		err:     errors.New("partial error"),
+	}
+	c := newCoalescer(50*time.Millisecond, 10, reads.readOne, reads.readBatch)
+
+	values, errs := readConcurrently(c, "1", "2", "3")
+
+	assert.Equal(t, []string{"value-1", "", "value-3"}, values)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ErrGraphqlResultIsEmpty)
+	assert.NoError(t, errs[2])
+	assert.Equal(t, []string{"2"}, reads.single)
+}
+
+func TestCoalescerDisabled(t *testing.T) {
+	reads := &fakeReads{}
+	c := newCoalescer(0, 10, reads.readOne, reads.readBatch)
+
+	_, errs := readConcurrently(c, "1", "2")
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.Empty(t, reads.batches)
+	assert.ElementsMatch(t, []string{"1", "2"}, reads.single)
+}
+
+func TestCoalescerCallerCancellation(t *testing.T) {
+	var calls atomic.Int32
+
+	block := make(chan struct{})
+	defer close(block)
+
+	c := newCoalescer(time.Millisecond, 10,
+		func(ctx context.Context, id string) (string, error) {
+			calls.Add(1)
+			<-block
+
+			return id, nil
+		},
+		func(ctx context.Context, ids []string) (map[int]string, error) {
+			return nil, nil
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := c.read(ctx, "1")
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestGetReadBatchWindow(t *testing.T) {
+	t.Setenv(EnvReadBatchWindow, "25")
+	assert.Equal(t, 25*time.Millisecond, getReadBatchWindow())
+
+	t.Setenv(EnvReadBatchWindow, "0")
+	assert.Equal(t, time.Duration(0), getReadBatchWindow())
+
+	t.Setenv(EnvReadBatchWindow, "invalid")
+	assert.Equal(t, defaultReadBatchWindow, getReadBatchWindow())
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -28,7 +29,7 @@ func (client *Client) ReadDNSFilteringProfile(ctx context.Context, profileID str
 	variables := newVars(
 		gqlID(profileID),
 		cursor(query.CursorGroups),
-		pageLimit(defaultPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadDNSFilteringProfile{}
@@ -55,17 +56,43 @@ func (client *Client) CreateDNSFilteringProfile(ctx context.Context, name string
 	variables := newVars(
 		gqlVar(name, "name"),
 		cursor(query.CursorGroups),
-		pageLimit(defaultPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	var response query.CreateDNSFilteringProfile
-	if err := client.mutateWithTimeout(ctx, &response, variables, opr, attr{name: name}); err != nil {
+
+	createdID, err := client.create(ctx, &response, variables, opr, client.lookupCreatedDNSFilteringProfile(name), attr{name: name})
+	if err != nil {
 		return nil, err
 	}
 
+	if createdID != "" {
+		return client.ReadDNSFilteringProfile(ctx, createdID)
+	}
+
 	return response.Entity.ToModel(), nil
 }
 
+// lookupCreatedDNSFilteringProfile searches for the DNS filtering profiles with the name.
+func (client *Client) lookupCreatedDNSFilteringProfile(name string) lookupFunc {
+	return func(ctx context.Context) ([]string, error) {
+		profiles, err := client.ReadShallowDNSFilteringProfiles(ctx)
+		if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil, err
+		}
+
+		var profileIDs []string
+
+		for _, profile := range profiles {
+			if profile.Name == name {
+				profileIDs = append(profileIDs, profile.ID)
+			}
+		}
+
+		return profileIDs, nil
+	}
+}
+
 type PrivacyCategoryConfigInput struct {
 	BlockAdsAndTrackers    bool `json:"blockAdsAndTrackers"`
 	BlockAffiliate         bool `json:"blockAffiliate"`
@@ -165,7 +192,7 @@ func (client *Client) UpdateDNSFilteringProfile(ctx context.Context, input *mode
 		gqlVar(newSecurityCategoryConfigInput(input.SecurityCategories), "securityCategoryConfig"),
 		gqlVar(newContentCategoryConfigInput(input.ContentCategories), "contentCategoryConfig"),
 		cursor(query.CursorGroups),
-		pageLimit(defaultPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	var response query.UpdateDNSFilteringProfile
@@ -182,6 +209,23 @@ func (client *Client) UpdateDNSFilteringProfile(ctx context.Context, input *mode
 	return response.Entity.ToModel(), nil
 }
 
+func (client *Client) UpdateDNSFilteringProfilePriority(ctx context.Context, profileID string, priority float64) error {
+	opr := resourceDNSFilteringProfile.update().withCustomName("updateDNSFilteringProfilePriority")
+
+	if profileID == "" {
+		return opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(profileID),
+		gqlVar(priority, "priority"),
+	)
+
+	var response query.UpdateDNSFilteringProfilePriority
+
+	return client.mutateWithTimeout(ctx, &response, variables, opr, attr{id: profileID})
+}
+
 func (client *Client) readDNSFilteringProfileGroupsAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GroupIDEdge], error) {
 	opr := resourceGroup.read().withCustomName("readDNSFilteringProfileGroupsAfter")
 
@@ -1,8 +1,12 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
 
 	"github.com/hasura/go-graphql-client"
 )
@@ -21,6 +25,131 @@ var (
 	ErrGraphqlEmailIsEmpty       = errors.New("email is empty")
 )
 
+// The classes of API errors, matched with errors.Is, e.g. errors.Is(err, ErrNotFound).
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrValidation       = errors.New("validation failed")
+	ErrConflict         = errors.New("conflict")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrTransient        = errors.New("transient error")
+	ErrAuthExpired      = errors.New("authentication expired")
+	// ErrEndpointNotFound is returned when the API itself wasn't found, e.g. on a wrong network. Unlike ErrNotFound,
+	// it says nothing about the requested object, so it is fatal: never retried and never removing the state.
+	ErrEndpointNotFound = errors.New("API endpoint not found")
+)
+
+// graphqlErrorCodes maps the `code` extension of GraphQL errors to the error classes.
+//
+//nolint:gochecknoglobals
+var graphqlErrorCodes = map[string]error{
+	"NOT_FOUND":                 ErrNotFound,
+	"FORBIDDEN":                 ErrPermissionDenied,
+	"PERMISSION_DENIED":         ErrPermissionDenied,
+	"UNAUTHENTICATED":           ErrAuthExpired,
+	"BAD_USER_INPUT":            ErrValidation,
+	"GRAPHQL_VALIDATION_FAILED": ErrValidation,
+	"VALIDATION_ERROR":          ErrValidation,
+	"CONFLICT":                  ErrConflict,
+	"RATE_LIMITED":              ErrRateLimited,
+	"INTERNAL_SERVER_ERROR":     ErrTransient,
+	"SERVICE_UNAVAILABLE":       ErrTransient,
+}
+
+// classifyError returns the class of the error, or nil if the error can't be classified.
+func classifyError(err error) error {
+	var (
+		networkErr graphql.NetworkError
+		httpErr    *HTTPError
+		mutErr     *MutationError
+		gqlErrs    graphql.Errors
+		netErr     net.Error
+	)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrGraphqlResultIsEmpty):
+		return ErrNotFound
+	case errors.As(err, &networkErr):
+		return classifyStatusCode(networkErr.StatusCode())
+	case errors.As(err, &httpErr):
+		return classifyStatusCode(httpErr.StatusCode)
+	case errors.As(err, &mutErr):
+		// the request was processed, but the API rejected it
+		return classifyMutationError(mutErr.Message)
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return ErrTransient
+	case errors.As(err, &gqlErrs):
+		return classifyGraphqlErrors(gqlErrs)
+	}
+
+	return nil
+}
+
+func classifyStatusCode(statusCode int) error {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return ErrAuthExpired
+	case statusCode == http.StatusForbidden:
+		return ErrPermissionDenied
+	case statusCode == http.StatusNotFound:
+		return ErrEndpointNotFound
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode == http.StatusRequestTimeout, statusCode >= http.StatusInternalServerError:
+		return ErrTransient
+	}
+
+	return nil
+}
+
+// classifyMutationError returns the class of the code of a mutation payload error, e.g. "NOT_FOUND: remote network".
+// The messages without a known code are validation errors, whatever their text.
+func classifyMutationError(message string) error {
+	code, _, _ := strings.Cut(message, ":")
+	if class, ok := graphqlErrorCodes[strings.ToUpper(strings.TrimSpace(code))]; ok {
+		return class
+	}
+
+	return ErrValidation
+}
+
+// classifyGraphqlErrors returns the class of the first GraphQL error with a known code.
+func classifyGraphqlErrors(errs graphql.Errors) error {
+	for _, err := range errs {
+		code, _ := err.Extensions["code"].(string)
+
+		if class, ok := graphqlErrorCodes[strings.ToUpper(code)]; ok {
+			return class
+		}
+	}
+
+	return nil
+}
+
+// graphqlErrors joins the messages of GraphQL errors, while keeping them available to errors.Is and errors.As.
+type graphqlErrors struct {
+	errs graphql.Errors
+}
+
+func (e *graphqlErrors) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e *graphqlErrors) Unwrap() error {
+	return e.errs
+}
+
 type HTTPError struct {
 	RequestURI string
 	StatusCode int
@@ -45,6 +174,8 @@ type APIError struct {
 	Resource     string
 	ID           graphql.ID
 	Name         string
+	// Class is one of the error classes, e.g. ErrNotFound, or nil if the error is not classified.
+	Class error
 }
 
 func NewAPIErrorWithID(wrappedError error, operation, resource, id string) *APIError {
@@ -53,6 +184,7 @@ func NewAPIErrorWithID(wrappedError error, operation, resource, id string) *APIE
 		Operation:    operation,
 		Resource:     resource,
 		ID:           graphql.ID(id),
+		Class:        classifyError(wrappedError),
 	}
 }
 
@@ -62,6 +194,7 @@ func NewAPIErrorWithName(wrappedError error, operation, resource, name string) *
 		Operation:    operation,
 		Resource:     resource,
 		Name:         name,
+		Class:        classifyError(wrappedError),
 	}
 }
 
@@ -70,6 +203,7 @@ func NewAPIError(wrappedError error, operation, resource string) *APIError {
 		WrappedError: wrappedError,
 		Operation:    operation,
 		Resource:     resource,
+		Class:        classifyError(wrappedError),
 	}
 }
 
@@ -103,6 +237,11 @@ func (e *APIError) Unwrap() error {
 	return e.WrappedError
 }
 
+// Is matches the class of the error.
+func (e *APIError) Is(target error) bool {
+	return e.Class != nil && e.Class == target
+}
+
 type MutationError struct {
 	Message string
 }
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hasura/go-graphql-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{name: "nil", err: nil, expected: nil},
+		{name: "unknown", err: errors.New("unknown"), expected: nil},
+		{name: "empty result", err: ErrGraphqlResultIsEmpty, expected: ErrNotFound},
+		{name: "http unauthorized", err: NewHTTPError("/path", http.StatusUnauthorized, nil), expected: ErrAuthExpired},
+		{name: "http forbidden", err: NewHTTPError("/path", http.StatusForbidden, nil), expected: ErrPermissionDenied},
+		{name: "http not found", err: NewHTTPError("/path", http.StatusNotFound, nil), expected: ErrEndpointNotFound},
+		{name: "http bad request", err: NewHTTPError("/path", http.StatusBadRequest, nil), expected: ErrValidation},
+		{name: "http conflict", err: NewHTTPError("/path", http.StatusConflict, nil), expected: ErrConflict},
+		{name: "http too many requests", err: NewHTTPError("/path", http.StatusTooManyRequests, nil), expected: ErrRateLimited},
+		{name: "http bad gateway", err: NewHTTPError("/path", http.StatusBadGateway, nil), expected: ErrTransient},
+		{name: "http teapot", err: NewHTTPError("/path", http.StatusTeapot, nil), expected: nil},
+		{name: "mutation error", err: NewMutationError("name is taken"), expected: ErrValidation},
+		{name: "mutation message not found", err: NewMutationError("Remote network does not exist"), expected: ErrValidation},
+		{name: "mutation message permission denied", err: NewMutationError("Permission denied for this API key"), expected: ErrValidation},
+		{name: "mutation not found code", err: NewMutationError("NOT_FOUND: remote network"), expected: ErrNotFound},
+		{name: "mutation conflict code", err: NewMutationError("CONFLICT: a group with this name already exists"), expected: ErrConflict},
+		{name: "mutation code", err: NewMutationError("RATE_LIMITED: try again later"), expected: ErrRateLimited},
+		{name: "mutation unknown code", err: NewMutationError("SOMETHING: name is taken"), expected: ErrValidation},
+		{name: "deadline", err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded), expected: ErrTransient},
+		{name: "network timeout", err: &url.Error{Op: "Post", URL: "/path", Err: timeoutError{}}, expected: ErrTransient},
+		{
+			name:     "graphql code",
+			err:      graphql.Errors{{Message: "other"}, {Message: "no access", Extensions: map[string]any{"code": "FORBIDDEN"}}},
+			expected: ErrPermissionDenied,
+		},
+		{
+			name:     "graphql lower case code",
+			err:      graphql.Errors{{Message: "gone", Extensions: map[string]any{"code": "not_found"}}},
+			expected: ErrNotFound,
+		},
+		{
+			name:     "graphql unknown code",
+			err:      graphql.Errors{{Message: "oops", Extensions: map[string]any{"code": "SOMETHING"}}},
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, classifyError(c.err))
+		})
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	err := resourceUser.read().apiError(ErrGraphqlResultIsEmpty, attr{id: "user-id"})
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, err, ErrGraphqlResultIsEmpty)
+	assert.NotErrorIs(t, err, ErrTransient)
+	assert.EqualError(t, err, "failed to read user with id user-id: query result is empty")
+}
+
+func TestAPIErrorKeepsGraphqlErrors(t *testing.T) {
+	gqlErr := graphql.Errors{
+		{Message: "first", Extensions: map[string]any{"code": "CONFLICT"}},
+		{Message: "second"},
+	}
+
+	err := resourceGroup.update().apiError(gqlErr)
+
+	var target graphql.Errors
+
+	assert.EqualError(t, err, "failed to update group: first; second")
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.ErrorAs(t, err, &target)
+}
+
+func TestShouldRetryQuery(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	transientErr := NewAPIError(context.DeadlineExceeded, operationRead, string(resourceUser))
+
+	assert.False(t, shouldRetryQuery(context.Background(), nil))
+	assert.True(t, shouldRetryQuery(context.Background(), transientErr))
+	assert.False(t, shouldRetryQuery(cancelled, transientErr))
+	assert.False(t, shouldRetryQuery(context.Background(), NewAPIError(ErrGraphqlResultIsEmpty, operationRead, string(resourceUser))))
+	assert.False(t, shouldRetryQuery(context.Background(), errors.New("timeout in the message only")))
+}
@@ -94,3 +94,36 @@ func (client *Client) DeleteGateway(ctx context.Context, gatewayID string) error
 
 	return client.mutate(ctx, &response, newVars(gqlID(gatewayID)), opr, attr{id: gatewayID})
 }
+
+func (client *Client) ReadGateways(ctx context.Context) ([]*model.Gateway, error) {
+	opr := resourceGateway.read().withCustomName("readGateways")
+
+	variables := newVars(
+		cursor(query.CursorGateways),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadGateways{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	if err := response.FetchPages(ctx, client.readGatewaysAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel(), nil
+}
+
+func (client *Client) readGatewaysAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GatewayEdge], error) {
+	opr := resourceGateway.read().withCustomName("readGateways")
+
+	variables[query.CursorGateways] = cursor
+
+	response := query.ReadGateways{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
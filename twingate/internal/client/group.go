@@ -2,8 +2,8 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 
@@ -26,19 +26,44 @@ func (client *Client) CreateGroup(ctx context.Context, input *model.Group) (*mod
 	)
 
 	response := query.CreateGroup{}
-	if err := client.mutate(ctx, &response, variables, opr, attr{name: input.Name}); err != nil {
+
+	createdID, err := client.create(ctx, &response, variables, opr, client.lookupCreatedGroup(input), attr{name: input.Name})
+	if err != nil {
 		return nil, err
 	}
 
 	group := response.ToModel()
+
+	if createdID != "" {
+		if group, err = client.ReadGroup(ctx, createdID); err != nil {
+			return nil, err
+		}
+	}
 	group.Users = input.Users
 	group.IsAuthoritative = input.IsAuthoritative
 
-	setResource(group)
+	setResource(ctx, group)
 
 	return group, nil
 }
 
+// lookupCreatedGroup searches for the manual groups with the name of the input.
+func (client *Client) lookupCreatedGroup(input *model.Group) lookupFunc {
+	return func(ctx context.Context) ([]string, error) {
+		groups, err := client.readGroups(ctx, &model.GroupsFilter{
+			Name:  &input.Name,
+			Types: []string{model.GroupTypeManual},
+		})
+		if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil, err
+		}
+
+		return utils.Map(groups, func(group *model.Group) string {
+			return group.ID
+		}), nil
+	}
+}
+
 func (client *Client) ReadGroup(ctx context.Context, groupID string) (*model.Group, error) {
 	opr := resourceGroup.read()
 
@@ -46,13 +71,13 @@ func (client *Client) ReadGroup(ctx context.Context, groupID string) (*model.Gro
 		return nil, opr.apiError(ErrGraphqlIDIsEmpty)
 	}
 
-	if res, ok := getResource[*model.Group](groupID); ok {
-		log.Printf("[DEBUG] ReadGroup: found group in cache: %v", res.Name)
+	if res, ok := getResource[*model.Group](ctx, groupID); ok {
+		logDebug(ctx, "ReadGroup: found group in cache", map[string]any{"name": res.Name})
 
 		return res, nil
 	}
 
-	log.Println("[DEBUG] ReadGroup: group not found in cache: fallback to query API")
+	logDebug(ctx, "ReadGroup: group not found in cache: fallback to query API")
 
 	variables := newVars(
 		gqlID(groupID),
@@ -73,29 +98,35 @@ func (client *Client) ReadGroup(ctx context.Context, groupID string) (*model.Gro
 
 	group := response.ToModel()
 
-	setResource(group)
+	setResource(ctx, group)
 
 	return group, nil
 }
 
 func (client *Client) ReadGroups(ctx context.Context, filter *model.GroupsFilter) ([]*model.Group, error) {
-	opr := resourceGroup.read().withCustomName("readGroups")
-
 	// cache is not used when cache filter config set or cache disabled
 	if isCacheReady[*model.Group]() {
-		if matched := matchResources[*model.Group](filter); len(matched) > 0 {
-			log.Printf(
-				"[DEBUG] ReadGroups: matched #%d groups from cache: %v",
-				len(matched), utils.Map(matched, func(item *model.Group) string {
+		if matched := matchResources[*model.Group](ctx, filter); len(matched) > 0 {
+			logDebug(ctx, "ReadGroups: matched groups from cache", map[string]any{
+				"count": len(matched),
+				"names": utils.Map(matched, func(item *model.Group) string {
 					return item.Name
-				}))
+				}),
+			})
 
 			return matched, nil
 		}
 
-		log.Println("[DEBUG] ReadGroups: no matched groups in cache: fallback to query API")
+		logDebug(ctx, "ReadGroups: no matched groups in cache: fallback to query API")
 	}
 
+	return client.readGroups(ctx, filter)
+}
+
+// readGroups reads the groups matching the filter from the API, bypassing the cache.
+func (client *Client) readGroups(ctx context.Context, filter *model.GroupsFilter) ([]*model.Group, error) {
+	opr := resourceGroup.read().withCustomName("readGroups")
+
 	variables := newVars(
 		gqlNullable(query.NewGroupFilterInput(filter), "filter"),
 		cursor(query.CursorGroups),
@@ -144,7 +175,7 @@ func (client *Client) ReadFullGroupsByName(ctx context.Context, filter *model.Gr
 		gqlNullable(query.NewGroupFilterInput(filter), "filter"),
 		cursor(query.CursorGroups),
 		cursor(query.CursorUsers),
-		pageLimit(extendedPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadGroups{}
@@ -174,7 +205,7 @@ func (client *Client) ReadFullGroups(ctx context.Context) ([]*model.Group, error
 		gqlNullable(query.NewGroupFilterInput(nil), "filter"),
 		cursor(query.CursorGroups),
 		cursor(query.CursorUsers),
-		pageLimit(extendedPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadGroups{}
@@ -183,18 +214,21 @@ func (client *Client) ReadFullGroups(ctx context.Context) ([]*model.Group, error
 	}
 
 	oprCtx := withOperationCtx(ctx, opr)
+	groups := make([]*model.Group, 0, len(response.Edges))
 
-	if err := response.FetchPages(oprCtx, client.readGroupsAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+	for edge, err := range response.All(oprCtx, client.readGroupsAfter, variables, true) {
+		if err != nil {
+			return nil, err //nolint
+		}
 
-	for i, group := range response.Edges {
-		if err := response.Edges[i].Node.Users.FetchPages(oprCtx, client.readGroupUsersAfter, newVars(pageLimit(client.pageLimit), gqlID(group.Node.ID))); err != nil {
-			return nil, fmt.Errorf("%s: failed to read users for group %s: %w", opr.String(), group.Node.ID, err)
+		if err := edge.Node.Users.FetchPages(oprCtx, client.readGroupUsersAfter, newVars(pageLimit(client.pageLimit), gqlID(edge.Node.ID))); err != nil {
+			return nil, fmt.Errorf("%s: failed to read users for group %s: %w", opr.String(), edge.Node.ID, err)
 		}
+
+		groups = append(groups, edge.Node.ToModel())
 	}
 
-	return response.ToModel(), nil
+	return groups, nil
 }
 
 func (client *Client) UpdateGroup(ctx context.Context, input *model.Group) (*model.Group, error) {
@@ -232,7 +266,7 @@ func (client *Client) UpdateGroup(ctx context.Context, input *model.Group) (*mod
 	group := response.Entity.ToModel()
 	group.IsAuthoritative = input.IsAuthoritative
 
-	setResource(group)
+	setResource(ctx, group)
 
 	return group, nil
 }
@@ -251,6 +285,31 @@ func (client *Client) DeleteGroup(ctx context.Context, groupID string) error {
 	return client.mutate(ctx, &response, newVars(gqlID(groupID)), opr, attr{id: groupID})
 }
 
+func (client *Client) AddGroupUsers(ctx context.Context, groupID string, userIDs []string) error {
+	opr := resourceGroup.update()
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	if groupID == "" {
+		return opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	invalidateResource[*model.Group](groupID)
+
+	variables := newVars(
+		gqlID(groupID),
+		gqlIDs(userIDs, "addedUserIds"),
+		cursor(query.CursorUsers),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.UpdateGroupAddUsers{}
+
+	return client.mutate(ctx, &response, variables, opr, attr{id: groupID})
+}
+
 func (client *Client) DeleteGroupUsers(ctx context.Context, groupID string, userIDs []string) error {
 	opr := resourceGroup.update()
 
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// ErrCreateLookupAmbiguous is returned when a create timed out and several new objects match the created one,
+// so the client can neither adopt one of them nor retry without risking a duplicate.
+var ErrCreateLookupAmbiguous = errors.New("several objects match the object being created")
+
+// lookupFunc returns the IDs of the objects matching the object of a create mutation.
+type lookupFunc func(ctx context.Context) ([]string, error)
+
+type ctxIdempotencyKeyType string
+
+const ctxIdempotencyKey ctxIdempotencyKeyType = "ctx_idempotency_key"
+
+// withIdempotencyKey generates the key sent with all the requests of a create mutation, including its retries.
+func withIdempotencyKey(ctx context.Context) context.Context {
+	if getIdempotencyKeyFromCtx(ctx) != "" {
+		return ctx
+	}
+
+	key, _ := uuid.GenerateUUID()
+
+	return context.WithValue(ctx, ctxIdempotencyKey, key)
+}
+
+func getIdempotencyKeyFromCtx(ctx context.Context) string {
+	val, ok := ctx.Value(ctxIdempotencyKey).(string)
+	if !ok {
+		return ""
+	}
+
+	return val
+}
+
+// isRejectedRequest reports whether the API rejected the request without processing it,
+// so that a create can be retried without looking up the created object.
+func isRejectedRequest(resp *http.Response) bool {
+	return resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable)
+}
+
+// create sends a create mutation with an idempotency key. When the mutation fails with a transient error,
+// e.g. a timeout, the object may have been created anyway: the lookup searches for it before the mutation
+// is retried, and returns the ID of the object to adopt. The ID is empty when the mutation succeeded.
+// The objects matching before the first attempt are never adopted.
+func (client *Client) create(ctx context.Context, resp MutationResponse, variables map[string]any, opr operation, lookup lookupFunc, attrs ...attr) (string, error) {
+	var (
+		err         error
+		existingIDs []string
+	)
+
+	opts := client.options.Mutation
+
+	// without retries, the created object is never looked up
+	if opts.MaxRetries > 0 {
+		existingIDs, err = lookup(ctx)
+		if err != nil {
+			// the created object couldn't be told apart from the existing ones, so the create isn't retried
+			logWarn(ctx, "Failed to look up the objects matching the object before the operation", map[string]any{
+				logFieldOperation: opr.String(),
+				logFieldError:     err.Error(),
+			})
+
+			opts.MaxRetries = 0
+			err = nil
+		}
+	}
+
+	// the retries of the requests and of the operation share the budget
+	mutationCtx := withRetryBudget(withIdempotencyKey(ctx), opts.MaxRetries)
+
+	for i := 0; i == 0 || shouldRetryQuery(mutationCtx, err) && takeRetry(mutationCtx); i++ {
+		if i > 0 {
+			objectID, lookupErr := client.lookupCreated(ctx, lookup, existingIDs, opr, err)
+			if lookupErr != nil || objectID != "" {
+				return objectID, lookupErr
+			}
+
+			client.telemetry.recordQueryRetry(mutationCtx, opr)
+			logWarn(mutationCtx, "Retrying the operation", map[string]any{
+				logFieldOperation: opr.String(),
+				logFieldAttempt:   i + 1,
+				logFieldError:     err.Error(),
+			})
+		}
+
+		timeoutCtx, cancel := withTimeout(withAttemptCtx(mutationCtx, i+1), opts.Timeout)
+		err = client.mutate(timeoutCtx, resp, variables, opr, attrs...)
+
+		cancel()
+	}
+
+	return "", err
+}
+
+// lookupCreated returns the ID of the object created by a failed mutation, or an empty ID if it wasn't created.
+// Only the objects missing from existingIDs, the objects matching before the create, can be the created one.
+func (client *Client) lookupCreated(ctx context.Context, lookup lookupFunc, existingIDs []string, opr operation, createErr error) (string, error) {
+	logWarn(ctx, "Looking up the object before retrying the operation", map[string]any{
+		logFieldOperation: opr.String(),
+		logFieldError:     createErr.Error(),
+	})
+
+	objectIDs, err := lookup(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to look up the created object: %w", createErr, err)
+	}
+
+	createdIDs := slices.DeleteFunc(objectIDs, func(id string) bool {
+		return slices.Contains(existingIDs, id)
+	})
+
+	switch len(createdIDs) {
+	case 0:
+		return "", nil
+	case 1:
+		logInfo(ctx, "Adopting the object created by the operation", map[string]any{
+			logFieldOperation: opr.String(),
+			"id":              createdIDs[0],
+		})
+
+		return createdIDs[0], nil
+	}
+
+	return "", fmt.Errorf("%w: %w", createErr, ErrCreateLookupAmbiguous)
+}
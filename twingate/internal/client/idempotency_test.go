@@ -0,0 +1,189 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	groupCreateJSON = `{"data": {"groupCreate": {"entity": {"id": "group-2", "name": "test"}, "ok": true, "error": null}}}`
+	groupReadJSON   = `{"data": {"group": {"id": %q, "name": "test", "type": "MANUAL", "isActive": true}}}`
+	groupsEmptyJSON = `{"data": {"groups": {"edges": []}}}`
+	groupsFoundJSON = `{"data": {"groups": {"edges": [{"node": {"id": "group-1", "name": "test", "type": "MANUAL", "isActive": true}}]}}}`
+	groupsTwoJSON   = `{"data": {"groups": {"edges": [{"node": {"id": "group-1", "name": "test"}}, {"node": {"id": "group-3", "name": "test"}}]}}}`
+)
+
+// createTestServer serves the create and lookup requests of groups, and records the operations
+// and the idempotency keys of the requests.
+type createTestServer struct {
+	mu         sync.Mutex
+	operations []string
+	keys       []string
+
+	// createResponses are the status codes of the createGroup requests, 0 times out the request
+	createResponses []int
+	// groupsResponses are the responses of the readGroups requests, the last one is repeated
+	groupsResponses []string
+}
+
+func (s *createTestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	s.mu.Lock()
+	s.operations = append(s.operations, body.OperationName)
+	s.keys = append(s.keys, r.Header.Get(headerIdempotency))
+
+	status := http.StatusOK
+	if body.OperationName == "createGroup" && len(s.createResponses) > 0 {
+		status, s.createResponses = s.createResponses[0], s.createResponses[1:]
+	}
+
+	groupsJSON := groupsEmptyJSON
+	if body.OperationName == "readGroups" && len(s.groupsResponses) > 0 {
+		groupsJSON = s.groupsResponses[0]
+
+		if len(s.groupsResponses) > 1 {
+			s.groupsResponses = s.groupsResponses[1:]
+		}
+	}
+	s.mu.Unlock()
+
+	switch {
+	case status == 0:
+		<-r.Context().Done()
+	case status != http.StatusOK:
+		w.WriteHeader(status)
+	case body.OperationName == "createGroup":
+		_, _ = w.Write([]byte(groupCreateJSON))
+	case body.OperationName == "readGroups":
+		_, _ = w.Write([]byte(groupsJSON))
+	case body.OperationName == "readGroup":
+		_, _ = fmt.Fprintf(w, groupReadJSON, body.Variables["id"])
+	}
+}
+
+func newCreateTestClient(t *testing.T, server *createTestServer) *Client {
+	t.Helper()
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	opts := OperationOptions{
+		Query:    newRetryTestOptions(0),
+		Mutation: newRetryTestOptions(2),
+	}
+	opts.Mutation.Timeout = 100 * time.Millisecond
+
+	return NewClient(t.Context(), httpServer.URL, "xxxx", opts, nil, DefaultAgent, "test", skipCache)
+}
+
+func TestCreateAdoptsCreatedObject(t *testing.T) {
+	server := &createTestServer{createResponses: []int{0}, groupsResponses: []string{groupsEmptyJSON, groupsFoundJSON}}
+	client := newCreateTestClient(t, server)
+
+	group, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "group-1", group.ID)
+	assert.Equal(t, []string{"readGroups", "createGroup", "readGroups", "readGroup"}, server.operations)
+	assert.Empty(t, server.keys[0])
+	assert.NotEmpty(t, server.keys[1])
+	assert.Empty(t, server.keys[2])
+}
+
+func TestCreateRetriesWhenNotCreated(t *testing.T) {
+	server := &createTestServer{createResponses: []int{http.StatusBadGateway}}
+	client := newCreateTestClient(t, server)
+
+	group, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "group-2", group.ID)
+	assert.Equal(t, []string{"readGroups", "createGroup", "readGroups", "createGroup"}, server.operations)
+	// the retry of the mutation sends the same key
+	assert.NotEmpty(t, server.keys[1])
+	assert.Equal(t, server.keys[1], server.keys[3])
+}
+
+func TestCreateRetriesRejectedRequests(t *testing.T) {
+	server := &createTestServer{createResponses: []int{http.StatusServiceUnavailable}}
+	client := newCreateTestClient(t, server)
+
+	group, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "group-2", group.ID)
+	assert.Equal(t, []string{"readGroups", "createGroup", "createGroup"}, server.operations)
+	assert.Equal(t, server.keys[1], server.keys[2])
+}
+
+func TestCreateRetriesNextToExistingObject(t *testing.T) {
+	// group-1 has the same name, but existed before the create
+	server := &createTestServer{createResponses: []int{0}, groupsResponses: []string{groupsFoundJSON}}
+	client := newCreateTestClient(t, server)
+
+	group, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "group-2", group.ID)
+	assert.Equal(t, []string{"readGroups", "createGroup", "readGroups", "createGroup"}, server.operations)
+}
+
+func TestCreateLookupAmbiguous(t *testing.T) {
+	server := &createTestServer{createResponses: []int{0}, groupsResponses: []string{groupsEmptyJSON, groupsTwoJSON}}
+	client := newCreateTestClient(t, server)
+
+	_, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+
+	require.ErrorIs(t, err, ErrCreateLookupAmbiguous)
+	assert.ErrorIs(t, err, ErrTransient)
+	assert.Equal(t, []string{"readGroups", "createGroup", "readGroups"}, server.operations)
+}
+
+func TestCreateAdoptsOnlyNewObject(t *testing.T) {
+	server := &createTestServer{createResponses: []int{0}, groupsResponses: []string{groupsFoundJSON, groupsTwoJSON}}
+	client := newCreateTestClient(t, server)
+
+	group, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+	require.NoError(t, err)
+
+	// group-1 existed before the create
+	assert.Equal(t, "group-3", group.ID)
+	assert.Equal(t, []string{"readGroups", "createGroup", "readGroups", "readGroup"}, server.operations)
+}
+
+func TestCreateWithoutExistingObjects(t *testing.T) {
+	server := &createTestServer{createResponses: []int{http.StatusBadGateway}, groupsResponses: []string{`{"errors": [{"message": "internal error"}]}`}}
+	client := newCreateTestClient(t, server)
+
+	_, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+
+	// the create isn't retried when the existing objects are unknown
+	require.ErrorIs(t, err, ErrTransient)
+	assert.Equal(t, []string{"readGroups", "createGroup"}, server.operations)
+}
+
+func TestCreateWithoutRetries(t *testing.T) {
+	server := &createTestServer{createResponses: []int{http.StatusBadGateway}, groupsResponses: []string{groupsFoundJSON}}
+	client := newCreateTestClient(t, server)
+	client.options.Mutation.MaxRetries = 0
+
+	_, err := client.CreateGroup(t.Context(), &model.Group{Name: "test"})
+
+	require.ErrorIs(t, err, ErrTransient)
+	assert.Equal(t, []string{"createGroup"}, server.operations)
+}
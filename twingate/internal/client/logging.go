@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// EnvLogFullBody disables the redaction of the logged request and response bodies.
+	EnvLogFullBody = "TWINGATE_LOG_FULL_BODY"
+
+	// logSubsystem is the tflog subsystem of the client, its level is set by TF_LOG_PROVIDER_TWINGATE_CLIENT.
+	logSubsystem = "client"
+	logLevelEnv  = "TF_LOG_PROVIDER_TWINGATE"
+
+	logFieldRequestID     = "request_id"
+	logFieldCorrelationID = "correlation_id"
+	logFieldOperation     = "operation"
+	logFieldAttempt       = "attempt"
+	logFieldRetry         = "retry"
+	logFieldURL           = "url"
+	logFieldStatus        = "status"
+	logFieldError         = "error"
+	logFieldRequestBody   = "request_body"
+	logFieldResponseBody  = "response_body"
+	logFieldCacheType     = "cache_type"
+
+	redactedValue = "***"
+)
+
+var (
+	// the JSON keys holding API keys, connector tokens, service account keys and emails.
+	//nolint:gochecknoglobals
+	sensitiveJSONValueRe = regexp.MustCompile(`(?i)("(?:access_?token|refresh_?token|token|api_?key|x-api-key|authorization|email)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+	//nolint:gochecknoglobals
+	emailRe = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+	//nolint:gochecknoglobals
+	bearerTokenRe = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`)
+)
+
+type ctxLoggerKeyType string
+
+const ctxLoggerKey ctxLoggerKeyType = "ctx_logger_key"
+
+// withLogger adds the client's tflog subsystem to the context. The subsystem masks the API key
+// in all fields, and the bearer tokens and emails unless the full body logging is enabled.
+func withLogger(ctx context.Context) context.Context {
+	if ctx.Value(ctxLoggerKey) != nil {
+		return ctx
+	}
+
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv(logLevelEnv, logSubsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, headerAPIKey, "Authorization")
+
+	if !isFullBodyLogEnabled() {
+		ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, logSubsystem, emailRe, bearerTokenRe)
+		ctx = tflog.SubsystemMaskMessageRegexes(ctx, logSubsystem, emailRe, bearerTokenRe)
+	}
+
+	return context.WithValue(ctx, ctxLoggerKey, true)
+}
+
+// withOperationLogger adds the operation and its attempt to all the client logs made with the context.
+func withOperationLogger(ctx context.Context, operation string) context.Context {
+	ctx = withLogField(ctx, logFieldOperation, operation)
+
+	if attempt := getAttemptFromCtx(ctx); attempt > 0 {
+		ctx = withLogField(ctx, logFieldAttempt, attempt)
+	}
+
+	return ctx
+}
+
+// withLogField adds a field to all the client logs made with the context.
+func withLogField(ctx context.Context, key string, value any) context.Context {
+	return tflog.SubsystemSetField(withLogger(ctx), logSubsystem, key, value)
+}
+
+func logDebug(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemDebug(withLogger(ctx), logSubsystem, msg, fields...)
+}
+
+func logInfo(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemInfo(withLogger(ctx), logSubsystem, msg, fields...)
+}
+
+func logWarn(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemWarn(withLogger(ctx), logSubsystem, msg, fields...)
+}
+
+func logError(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemError(withLogger(ctx), logSubsystem, msg, fields...)
+}
+
+// redactBody masks the tokens, keys and emails in a request or response body,
+// unless the full body logging is enabled with the TWINGATE_LOG_FULL_BODY env var.
+func redactBody(body []byte) string {
+	if isFullBodyLogEnabled() {
+		return string(body)
+	}
+
+	redacted := sensitiveJSONValueRe.ReplaceAll(body, []byte(`${1}"`+redactedValue+`"`))
+	redacted = bearerTokenRe.ReplaceAll(redacted, []byte(redactedValue))
+
+	return emailRe.ReplaceAllString(string(redacted), redactedValue)
+}
+
+func isFullBodyLogEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvLogFullBody))
+
+	return enabled
+}
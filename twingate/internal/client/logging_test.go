@@ -0,0 +1,116 @@
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "connector tokens",
+			body:     `{"data":{"connectorGenerateTokens":{"connectorTokens":{"accessToken":"access","refreshToken":"refresh"}}}}`,
+			expected: `{"data":{"connectorGenerateTokens":{"connectorTokens":{"accessToken":"***","refreshToken":"***"}}}}`,
+		},
+		{
+			name:     "connector tokens verification",
+			body:     `{"refresh_token": "refresh"}`,
+			expected: `{"refresh_token": "***"}`,
+		},
+		{
+			name:     "service account key token",
+			body:     `{"data":{"serviceAccountKeyCreate":{"token":"secret \"quoted\""}}}`,
+			expected: `{"data":{"serviceAccountKeyCreate":{"token":"***"}}}`,
+		},
+		{
+			name:     "email variable",
+			body:     `{"variables":{"email":"user@example.com","role":"ADMIN"}}`,
+			expected: `{"variables":{"email":"***","role":"ADMIN"}}`,
+		},
+		{
+			name:     "email in error message",
+			body:     `{"errors":[{"message":"user user@example.com already exists"}]}`,
+			expected: `{"errors":[{"message":"user *** already exists"}]}`,
+		},
+		{
+			name:     "bearer token",
+			body:     `Authorization: Bearer abc.def-ghi`,
+			expected: `Authorization: ***`,
+		},
+		{
+			name:     "no secrets",
+			body:     `{"variables":{"id":"R3JvdXA6MQ==","name":"group"}}`,
+			expected: `{"variables":{"id":"R3JvdXA6MQ==","name":"group"}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, redactBody([]byte(c.body)))
+		})
+	}
+}
+
+func TestRedactBodyWithFullBodyEnabled(t *testing.T) {
+	t.Setenv(EnvLogFullBody, "true")
+
+	body := `{"variables":{"email":"user@example.com"}}`
+
+	assert.Equal(t, body, redactBody([]byte(body)))
+}
+
+func TestClientLogsOperationFields(t *testing.T) {
+	var logBuffer bytes.Buffer
+
+	ctx := tflogtest.RootLogger(t.Context(), &logBuffer)
+
+	client := newTestClient(t.Context())
+
+	httpmock.ActivateNonDefault(client.HTTPClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	httpmock.RegisterResponder(http.MethodPost, client.GraphqlServerURL,
+		httpmock.NewStringResponder(http.StatusOK, `{"data": {"connector": {"id": "test-id", "name": "test-name"}}}`))
+
+	_, err := client.ReadConnector(WithCallerCtx(ctx, "resource"), "test-id")
+	require.NoError(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&logBuffer)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, "Sending GraphQL query", entries[0]["@message"])
+	assert.Equal(t, "provider.client", entries[0]["@module"])
+	assert.Equal(t, "resource_readConnector", entries[0][logFieldOperation])
+}
+
+func TestClientLogsMaskSecrets(t *testing.T) {
+	var logBuffer bytes.Buffer
+
+	ctx := withLogger(tflogtest.RootLogger(t.Context(), &logBuffer))
+
+	logWarn(ctx, "Request for user@example.com failed", map[string]any{
+		headerAPIKey: "api-key",
+		logFieldURL:  "https://test.twindev.com/api/graphql/",
+		"header":     "Bearer token",
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&logBuffer)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, "Request for *** failed", entries[0]["@message"])
+	assert.Equal(t, "***", entries[0][headerAPIKey])
+	assert.Equal(t, "***", entries[0]["header"])
+	assert.Equal(t, "https://test.twindev.com/api/graphql/", entries[0][logFieldURL])
+}
@@ -1,9 +1,7 @@
 package client
 
 import (
-	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/hasura/go-graphql-client"
 	"github.com/iancoleman/strcase"
@@ -28,6 +26,7 @@ const (
 	resourceGateway                  resource = "gateway"
 	resourceSSHResource              resource = "SSH resource"
 	resourceKubernetesResource       resource = "Kubernetes resource"
+	resourceAPIToken                 resource = "API token"
 )
 
 const (
@@ -99,12 +98,7 @@ func (o operation) apiError(err error, attrs ...attr) *APIError {
 	}
 
 	if errs, ok := err.(graphql.Errors); ok { //nolint
-		var errMsgs []string
-		for _, e := range errs {
-			errMsgs = append(errMsgs, e.Message)
-		}
-
-		err = errors.New(strings.Join(errMsgs, "; ")) //nolint
+		err = &graphqlErrors{errs: errs}
 	}
 
 	if len(attrs) == 0 {
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	DefaultRetryWaitMin           = time.Second
+	DefaultRetryWaitMax           = 30 * time.Second
+	DefaultPageSize               = 50
+	DefaultMaxConcurrentMutations = 3
+
+	operationClassQuery      = "query"
+	operationClassMutation   = "mutation"
+	operationClassPagination = "pagination"
+)
+
+// RetryOptions configures the requests of a class of operations.
+type RetryOptions struct {
+	// Timeout limits each request of an operation, including reading the response.
+	Timeout time.Duration
+	// MaxRetries is the retry budget of an operation, shared by the retries of the failed
+	// requests and of the transient API errors.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between the retries.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+}
+
+// OperationOptions configures the queries, the mutations and the requests of the next pages of lists.
+type OperationOptions struct {
+	Query      RetryOptions
+	Mutation   RetryOptions
+	Pagination RetryOptions
+	// PageSize is the number of items read per page of lists.
+	PageSize int
+	// MaxConcurrentMutations is the number of mutations sent at the same time.
+	MaxConcurrentMutations int
+}
+
+// DefaultRetryOptions returns the options with the default backoff bounds.
+func DefaultRetryOptions(timeout time.Duration, maxRetries int) RetryOptions {
+	return RetryOptions{
+		Timeout:      timeout,
+		MaxRetries:   maxRetries,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
+	}
+}
+
+// DefaultOperationOptions returns the same timeout and retries for all the operations, with the default page size
+// and concurrency of the mutations.
+func DefaultOperationOptions(timeout time.Duration, maxRetries int) OperationOptions {
+	opts := DefaultRetryOptions(timeout, maxRetries)
+
+	return OperationOptions{
+		Query:                  opts,
+		Mutation:               opts,
+		Pagination:             opts,
+		PageSize:               DefaultPageSize,
+		MaxConcurrentMutations: DefaultMaxConcurrentMutations,
+	}
+}
+
+func (o OperationOptions) forClass(class string) RetryOptions {
+	switch class {
+	case operationClassMutation:
+		return o.Mutation
+	case operationClassPagination:
+		return o.Pagination
+	}
+
+	return o.Query
+}
+
+// operationTransport sends each request with the retryable client of its operation class.
+type operationTransport struct {
+	clients map[string]*retryablehttp.RoundTripper
+}
+
+func newOperationTransport(opts OperationOptions, httpTransport http.RoundTripper) *operationTransport {
+	clients := make(map[string]*retryablehttp.RoundTripper, 3) //nolint:mnd
+
+	for _, class := range []string{operationClassQuery, operationClassMutation, operationClassPagination} {
+		clients[class] = &retryablehttp.RoundTripper{Client: newRetryableClient(opts.forClass(class), httpTransport)}
+	}
+
+	return &operationTransport{clients: clients}
+}
+
+func (t *operationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.clients[getOperationClassFromCtx(req.Context())].RoundTrip(req) //nolint:wrapcheck
+}
+
+type ctxOperationClassKeyType string
+
+const ctxOperationClassKey ctxOperationClassKeyType = "ctx_operation_class_key"
+
+func withOperationClassCtx(ctx context.Context, class string) context.Context {
+	return context.WithValue(ctx, ctxOperationClassKey, class)
+}
+
+func getOperationClassFromCtx(ctx context.Context) string {
+	val, ok := ctx.Value(ctxOperationClassKey).(string)
+	if !ok {
+		return operationClassQuery
+	}
+
+	return val
+}
+
+// queryClass returns the class of a query, the next pages of lists are fetched by FetchPages.
+func queryClass(ctx context.Context) string {
+	if query.PageFromCtx(ctx) > 0 {
+		return operationClassPagination
+	}
+
+	return operationClassQuery
+}
+
+type ctxRetryBudgetKeyType string
+
+const ctxRetryBudgetKey ctxRetryBudgetKeyType = "ctx_retry_budget_key"
+
+// withRetryBudget limits the retries of an operation, unless the context already has a budget.
+func withRetryBudget(ctx context.Context, maxRetries int) context.Context {
+	if _, ok := ctx.Value(ctxRetryBudgetKey).(*atomic.Int64); ok {
+		return ctx
+	}
+
+	budget := &atomic.Int64{}
+	budget.Store(int64(maxRetries))
+
+	return context.WithValue(ctx, ctxRetryBudgetKey, budget)
+}
+
+// takeRetry reports whether the operation can be retried, and consumes one retry of its budget.
+// Requests made outside of operations are only limited by the max retries of their client.
+func takeRetry(ctx context.Context) bool {
+	budget, ok := ctx.Value(ctxRetryBudgetKey).(*atomic.Int64)
+	if !ok {
+		return true
+	}
+
+	return budget.Add(-1) >= 0
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const connectorsFirstPageJSON = `{
+  "data": {
+    "connectors": {
+      "pageInfo": {"endCursor": "cursor-1", "hasNextPage": true},
+      "edges": [{"node": {"id": "connector1", "name": "connector1"}}]
+    }
+  }
+}`
+
+func newRetryTestOptions(maxRetries int) RetryOptions {
+	return RetryOptions{
+		Timeout:      time.Second,
+		MaxRetries:   maxRetries,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: time.Millisecond,
+	}
+}
+
+// newOptionsTestServer returns a client of a server failing all the requests, except the first page of connectors.
+func newOptionsTestServer(t *testing.T, opts OperationOptions) (*Client, *atomic.Int64) {
+	t.Helper()
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		body, _ := io.ReadAll(r.Body)
+
+		if strings.Contains(string(body), "readConnectors") && !strings.Contains(string(body), "cursor-1") {
+			_, _ = w.Write([]byte(connectorsFirstPageJSON))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(t.Context(), server.URL, "xxxx", opts, nil, DefaultAgent, "test", skipCache), &requests
+}
+
+func TestOperationRetryBudget(t *testing.T) {
+	cases := []struct {
+		name     string
+		opts     OperationOptions
+		call     func(ctx context.Context, client *Client) error
+		expected int64
+	}{
+		{
+			name: "query retries share the budget",
+			opts: OperationOptions{Query: newRetryTestOptions(2)},
+			call: func(ctx context.Context, client *Client) error {
+				_, err := client.ReadConnector(ctx, "connector1")
+
+				return err
+			},
+			expected: 3,
+		},
+		{
+			name: "mutation without retries",
+			opts: OperationOptions{Query: newRetryTestOptions(2), Mutation: newRetryTestOptions(0)},
+			call: func(ctx context.Context, client *Client) error {
+				return client.DeleteConnector(ctx, "connector1")
+			},
+			expected: 1,
+		},
+		{
+			name: "pagination retries",
+			opts: OperationOptions{Query: newRetryTestOptions(0), Pagination: newRetryTestOptions(1)},
+			call: func(ctx context.Context, client *Client) error {
+				_, err := client.ReadConnectors(ctx, "", "")
+
+				return err
+			},
+			// the first page and the two attempts of the second one
+			expected: 3,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, requests := newOptionsTestServer(t, c.opts)
+
+			err := c.call(t.Context(), client)
+
+			require.Error(t, err)
+			assert.Equal(t, c.expected, requests.Load())
+		})
+	}
+}
+
+func TestNewClientPageSize(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		client := NewClient(t.Context(), "https://test.twindev.com", "xxxx",
+			OperationOptions{}, nil, DefaultAgent, "test", skipCache)
+
+		assert.Equal(t, DefaultPageSize, client.pageLimit)
+		assert.Equal(t, DefaultMaxConcurrentMutations, cap(client.ratelimiter))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		opts := DefaultOperationOptions(time.Second, 0)
+		opts.PageSize = 20
+		opts.MaxConcurrentMutations = 5
+
+		client := NewClient(t.Context(), "https://test.twindev.com", "xxxx", opts, nil, DefaultAgent, "test", skipCache)
+
+		assert.Equal(t, 20, client.pageLimit)
+		assert.Equal(t, 5, cap(client.ratelimiter))
+	})
+}
+
+func TestRetryBudget(t *testing.T) {
+	t.Run("no budget", func(t *testing.T) {
+		assert.True(t, takeRetry(t.Context()))
+	})
+
+	t.Run("budget is not overwritten", func(t *testing.T) {
+		ctx := withRetryBudget(t.Context(), 1)
+		ctx = withRetryBudget(ctx, 5)
+
+		assert.True(t, takeRetry(ctx))
+		assert.False(t, takeRetry(ctx))
+	})
+}
+
+func TestOperationOptionsForClass(t *testing.T) {
+	opts := OperationOptions{
+		Query:      newRetryTestOptions(1),
+		Mutation:   newRetryTestOptions(2),
+		Pagination: newRetryTestOptions(3),
+	}
+
+	assert.Equal(t, 1, opts.forClass(operationClassQuery).MaxRetries)
+	assert.Equal(t, 2, opts.forClass(operationClassMutation).MaxRetries)
+	assert.Equal(t, 3, opts.forClass(operationClassPagination).MaxRetries)
+	assert.Equal(t, operationClassQuery, queryClass(t.Context()))
+}
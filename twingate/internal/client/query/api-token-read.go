@@ -0,0 +1,12 @@
+package query
+
+// ReadAPIToken is the cheapest query of the network, used to validate the API token.
+type ReadAPIToken struct {
+	RemoteNetworks struct {
+		PageInfo PageInfo
+	} `graphql:"remoteNetworks(first: 1)"`
+}
+
+func (q ReadAPIToken) IsEmpty() bool {
+	return false
+}
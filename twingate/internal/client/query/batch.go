@@ -0,0 +1,16 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BatchIDVariable returns the name of the ID variable of the n-th read of a batch, starting at 1.
+func BatchIDVariable(n int) string {
+	return "id" + strconv.Itoa(n)
+}
+
+// batchField returns the aliased field of the n-th read of a batch, e.g. `r1: resource(id: $id1)`.
+func batchField(field string, n int) string {
+	return fmt.Sprintf("r%d: %s(id: $%s)", n, field, BatchIDVariable(n))
+}
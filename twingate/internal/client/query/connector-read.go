@@ -12,7 +12,8 @@ type ReadConnector struct {
 type gqlConnector struct {
 	IDName
 	RemoteNetwork struct {
-		ID graphql.ID
+		ID          graphql.ID
+		NetworkType string
 	}
 	HasStatusNotificationsEnabled bool
 	Hostname                      string
@@ -39,6 +40,7 @@ func (c gqlConnector) ToModel() *model.Connector {
 		ID:                   string(c.ID),
 		Name:                 c.Name,
 		NetworkID:            string(c.RemoteNetwork.ID),
+		NetworkType:          c.RemoteNetwork.NetworkType,
 		StatusUpdatesEnabled: &c.HasStatusNotificationsEnabled,
 		State:                c.State,
 		Hostname:             c.Hostname,
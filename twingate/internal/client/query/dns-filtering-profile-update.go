@@ -3,3 +3,11 @@ package query
 type UpdateDNSFilteringProfile struct {
 	DNSFilteringProfileEntityResponse `graphql:"dnsFilteringProfileUpdate(id: $id, name: $name, priority: $priority, allowedDomains: $allowedDomains, deniedDomains: $deniedDomains, fallbackMethod: $fallbackMethod, groups: $groups, privacyCategoryConfig: $privacyCategoryConfig, securityCategoryConfig: $securityCategoryConfig, contentCategoryConfig: $contentCategoryConfig)"`
 }
+
+type UpdateDNSFilteringProfilePriority struct {
+	OkError `graphql:"dnsFilteringProfileUpdate(id: $id, priority: $priority)"`
+}
+
+func (q UpdateDNSFilteringProfilePriority) IsEmpty() bool {
+	return false
+}
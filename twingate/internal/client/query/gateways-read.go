@@ -0,0 +1,30 @@
+package query
+
+import (
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+)
+
+const CursorGateways = "gatewaysEndCursor"
+
+type ReadGateways struct {
+	Gateways `graphql:"gateways(after: $gatewaysEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadGateways) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
+
+type Gateways struct {
+	PaginatedResource[*GatewayEdge]
+}
+
+type GatewayEdge struct {
+	Node *gqlGateway
+}
+
+func (g Gateways) ToModel() []*model.Gateway {
+	return utils.Map(g.Edges, func(edge *GatewayEdge) *model.Gateway {
+		return edge.Node.ToModel()
+	})
+}
@@ -4,6 +4,14 @@ type UpdateGroup struct {
 	GroupEntityResponse `graphql:"groupUpdate(id: $id, name: $name, addedUserIds: $addedUserIds)"`
 }
 
+type UpdateGroupAddUsers struct {
+	GroupEntityResponse `graphql:"groupUpdate(id: $id, addedUserIds: $addedUserIds)"`
+}
+
+func (q UpdateGroupAddUsers) IsEmpty() bool {
+	return q.Entity == nil
+}
+
 type UpdateGroupRemoveUsers struct {
 	GroupEntityResponse `graphql:"groupUpdate(id: $id, removedUserIds: $removedUserIds)"`
 }
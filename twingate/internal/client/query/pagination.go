@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"iter"
 )
 
 const PageLimit = "pageLimit"
@@ -24,8 +25,9 @@ func (r *PaginatedResource[E]) FetchPages(ctx context.Context, fetchNextPage Nex
 	}
 
 	page := r.PageInfo
-	for page.HasNextPage {
-		next, err := fetchNextPage(ctx, variables, page.EndCursor)
+	// the first page is fetched by the initial query
+	for number := 2; page.HasNextPage; number++ {
+		next, err := fetchNextPage(withPageCtx(ctx, number), variables, page.EndCursor)
 		if err != nil {
 			return err
 		}
@@ -36,3 +38,89 @@ func (r *PaginatedResource[E]) FetchPages(ctx context.Context, fetchNextPage Nex
 
 	return nil
 }
+
+type pageResult[E any] struct {
+	page *PaginatedResource[E]
+	err  error
+}
+
+// All returns an iterator over the edges of the fetched page, followed by the edges of the next pages
+// fetched by fetchNextPage. The pages are not accumulated, so callers can filter and convert the edges
+// while streaming. With prefetch, the next page is fetched while the edges of the current page are consumed,
+// fetchNextPage then runs in its own goroutine. The iteration stops after yielding an error.
+func (r *PaginatedResource[E]) All(ctx context.Context, fetchNextPage NextPageFunc[E], variables map[string]any, prefetch bool) iter.Seq2[E, error] {
+	return func(yield func(E, error) bool) {
+		if r == nil {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		page := r
+		// the first page is fetched by the initial query
+		for number := 2; ; number++ {
+			var next <-chan pageResult[E]
+			if prefetch && page.PageInfo.HasNextPage {
+				next = fetchPageAsync(withPageCtx(ctx, number), fetchNextPage, variables, page.PageInfo.EndCursor)
+			}
+
+			for _, edge := range page.Edges {
+				if !yield(edge, nil) {
+					return
+				}
+			}
+
+			if !page.PageInfo.HasNextPage {
+				return
+			}
+
+			var result pageResult[E]
+			if next != nil {
+				result = <-next
+			} else {
+				result.page, result.err = fetchNextPage(withPageCtx(ctx, number), variables, page.PageInfo.EndCursor)
+			}
+
+			if result.err != nil {
+				var zero E
+
+				yield(zero, result.err)
+
+				return
+			}
+
+			page = result.page
+		}
+	}
+}
+
+func fetchPageAsync[E any](ctx context.Context, fetchNextPage NextPageFunc[E], variables map[string]any, cursor string) <-chan pageResult[E] {
+	// buffered, so that the goroutine ends when the iteration stops early
+	result := make(chan pageResult[E], 1)
+
+	go func() {
+		page, err := fetchNextPage(ctx, variables, cursor)
+		result <- pageResult[E]{page: page, err: err}
+	}()
+
+	return result
+}
+
+type ctxPageKeyType string
+
+const ctxPageKey ctxPageKeyType = "ctx_page_key"
+
+func withPageCtx(ctx context.Context, page int) context.Context {
+	return context.WithValue(ctx, ctxPageKey, page)
+}
+
+// PageFromCtx returns the number of the page fetched by FetchPages, or 0 for other queries.
+func PageFromCtx(ctx context.Context) int {
+	val, ok := ctx.Value(ctxPageKey).(int)
+	if !ok {
+		return 0
+	}
+
+	return val
+}
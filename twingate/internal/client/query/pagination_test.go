@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -130,3 +131,134 @@ func TestFetchPages(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchPagesSetsPageNumber(t *testing.T) {
+	resource := &PaginatedResource[string]{
+		PageInfo: PageInfo{EndCursor: "cursor1", HasNextPage: true},
+		Edges:    []string{"edge1"},
+	}
+
+	var pages []int
+
+	err := resource.FetchPages(context.Background(), func(ctx context.Context, variables map[string]any, cursor string) (*PaginatedResource[string], error) {
+		pages = append(pages, PageFromCtx(ctx))
+
+		return &PaginatedResource[string]{
+			PageInfo: PageInfo{EndCursor: cursor + "0", HasNextPage: len(pages) < 2},
+		}, nil
+	}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, pages)
+	assert.Equal(t, 0, PageFromCtx(context.Background()))
+}
+
+func newTestPages(pages ...[]string) (*PaginatedResource[string], NextPageFunc[string]) {
+	newPage := func(number int) *PaginatedResource[string] {
+		return &PaginatedResource[string]{
+			PageInfo: PageInfo{
+				EndCursor:   fmt.Sprintf("cursor%d", number),
+				HasNextPage: number < len(pages),
+			},
+			Edges: pages[number-1],
+		}
+	}
+
+	return newPage(1), func(ctx context.Context, variables map[string]any, cursor string) (*PaginatedResource[string], error) {
+		number := PageFromCtx(ctx)
+		if cursor != fmt.Sprintf("cursor%d", number-1) {
+			return nil, errors.New("unexpected cursor")
+		}
+
+		if number > len(pages) {
+			return nil, errors.New("fetch error")
+		}
+
+		return newPage(number), nil
+	}
+}
+
+func TestAll(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("prefetch %v", prefetch), func(t *testing.T) {
+			t.Run("Multiple pages", func(t *testing.T) {
+				resource, fetchNextPage := newTestPages([]string{"edge1", "edge2"}, []string{"edge3"}, []string{"edge4"})
+
+				var edges []string
+
+				for edge, err := range resource.All(context.Background(), fetchNextPage, nil, prefetch) {
+					assert.NoError(t, err)
+
+					edges = append(edges, edge)
+				}
+
+				assert.Equal(t, []string{"edge1", "edge2", "edge3", "edge4"}, edges)
+			})
+
+			t.Run("Error fetching next page", func(t *testing.T) {
+				resource, _ := newTestPages([]string{"edge1"}, []string{"edge2"})
+
+				var (
+					edges []string
+					errs  []error
+				)
+
+				for edge, err := range resource.All(context.Background(), func(ctx context.Context, variables map[string]any, cursor string) (*PaginatedResource[string], error) {
+					return nil, errors.New("fetch error")
+				}, nil, prefetch) {
+					if err != nil {
+						errs = append(errs, err)
+
+						continue
+					}
+
+					edges = append(edges, edge)
+				}
+
+				assert.Equal(t, []string{"edge1"}, edges)
+				assert.Equal(t, []error{errors.New("fetch error")}, errs)
+			})
+
+			t.Run("Stop early", func(t *testing.T) {
+				resource, fetchNextPage := newTestPages([]string{"edge1", "edge2"}, []string{"edge3"})
+
+				var edges []string
+
+				for edge := range resource.All(context.Background(), fetchNextPage, nil, prefetch) {
+					edges = append(edges, edge)
+
+					break
+				}
+
+				assert.Equal(t, []string{"edge1"}, edges)
+			})
+
+			t.Run("Nil initial resource", func(t *testing.T) {
+				var resource *PaginatedResource[string]
+
+				for range resource.All(context.Background(), nil, nil, prefetch) {
+					t.Fatal("unexpected edge")
+				}
+			})
+		})
+	}
+}
+
+func TestAllPrefetchesNextPage(t *testing.T) {
+	resource, fetchNextPage := newTestPages([]string{"edge1"}, []string{"edge2"})
+
+	fetched := make(chan struct{})
+
+	for edge, err := range resource.All(context.Background(), func(ctx context.Context, variables map[string]any, cursor string) (*PaginatedResource[string], error) {
+		defer close(fetched)
+
+		return fetchNextPage(ctx, variables, cursor)
+	}, nil, true) {
+		assert.NoError(t, err)
+
+		if edge == "edge1" {
+			// the next page is fetched while the first page is consumed
+			<-fetched
+		}
+	}
+}
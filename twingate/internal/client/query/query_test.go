@@ -109,7 +109,8 @@ func TestReadConnectorQueryToModel(t *testing.T) {
 						Name: "connector-name",
 					},
 					RemoteNetwork: struct {
-						ID graphql.ID
+						ID          graphql.ID
+						NetworkType string
 					}{
 						ID: "connector-network-id",
 					},
@@ -130,7 +131,8 @@ func TestReadConnectorQueryToModel(t *testing.T) {
 						Name: "connector-name",
 					},
 					RemoteNetwork: struct {
-						ID graphql.ID
+						ID          graphql.ID
+						NetworkType string
 					}{
 						ID: "connector-network-id",
 					},
@@ -266,7 +268,8 @@ func TestReadConnectorsQueryToModel(t *testing.T) {
 										Name: "connector-name",
 									},
 									RemoteNetwork: struct {
-										ID graphql.ID
+										ID          graphql.ID
+										NetworkType string
 									}{
 										ID: "connector-network-id",
 									},
@@ -923,16 +926,26 @@ func TestReadSecurityPolicy(t *testing.T) {
 		},
 		{
 			query: ReadSecurityPolicy{
-				SecurityPolicy: &gqlSecurityPolicy{
-					IDName{
+				SecurityPolicy: &gqlSecurityPolicyDetails{
+					IDName: IDName{
 						ID:   "policy-id",
 						Name: "policy-name",
 					},
+					PolicyType: "RESOURCE",
+					Groups: gqlGroupIDs{
+						PaginatedResource: PaginatedResource[*GroupIDEdge]{
+							Edges: []*GroupIDEdge{
+								{Node: &gqlGroupID{IDName{ID: "group-id"}}},
+							},
+						},
+					},
 				},
 			},
 			expected: &model.SecurityPolicy{
-				ID:   "policy-id",
-				Name: "policy-name",
+				ID:         "policy-id",
+				Name:       "policy-name",
+				PolicyType: "RESOURCE",
+				Groups:     []string{"group-id"},
 			},
 		},
 	}
@@ -1753,6 +1766,41 @@ func TestUpdateGroup_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestUpdateGroupAddUsers_IsEmpty(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    UpdateGroupAddUsers
+		expected bool
+	}{
+		{
+			name: "UpdateGroupAddUsers with nil Entity",
+			query: UpdateGroupAddUsers{
+				GroupEntityResponse: GroupEntityResponse{
+					Entity: nil,
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "UpdateGroupAddUsers with non-nil Entity",
+			query: UpdateGroupAddUsers{
+				GroupEntityResponse: GroupEntityResponse{
+					Entity: &gqlGroup{},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.query.IsEmpty()
+
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
 func TestUpdateGroupRemoveUsers_IsEmpty(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -3020,7 +3068,7 @@ func TestReadSecurityPolicies_IsEmpty(t *testing.T) {
 				SecurityPolicies: SecurityPolicies{
 					PaginatedResource: PaginatedResource[*SecurityPolicyEdge]{
 						Edges: []*SecurityPolicyEdge{
-							{Node: &gqlSecurityPolicy{}},
+							{Node: &gqlSecurityPolicyDetails{}},
 						},
 					},
 				},
@@ -3062,16 +3110,16 @@ func TestReadSecurityPolicies_ToModel(t *testing.T) {
 					PaginatedResource: PaginatedResource[*SecurityPolicyEdge]{
 						Edges: []*SecurityPolicyEdge{
 							{
-								Node: &gqlSecurityPolicy{
-									IDName{
+								Node: &gqlSecurityPolicyDetails{
+									IDName: IDName{
 										ID:   "policy-id-1",
 										Name: "policy-name-1",
 									},
 								},
 							},
 							{
-								Node: &gqlSecurityPolicy{
-									IDName{
+								Node: &gqlSecurityPolicyDetails{
+									IDName: IDName{
 										ID:   "policy-id-2",
 										Name: "policy-name-2",
 									},
@@ -3083,12 +3131,14 @@ func TestReadSecurityPolicies_ToModel(t *testing.T) {
 			},
 			expected: []*model.SecurityPolicy{
 				{
-					ID:   "policy-id-1",
-					Name: "policy-name-1",
+					ID:     "policy-id-1",
+					Name:   "policy-name-1",
+					Groups: []string{},
 				},
 				{
-					ID:   "policy-id-2",
-					Name: "policy-name-2",
+					ID:     "policy-id-2",
+					Name:   "policy-name-2",
+					Groups: []string{},
 				},
 			},
 		},
@@ -5196,3 +5246,27 @@ func TestReadShallowResourcesWithTypeQueryIsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateDNSFilteringProfilePriority_IsEmpty(t *testing.T) {
+	cases := []struct {
+		query    UpdateDNSFilteringProfilePriority
+		expected bool
+	}{
+		{
+			query:    UpdateDNSFilteringProfilePriority{},
+			expected: false,
+		},
+		{
+			query: UpdateDNSFilteringProfilePriority{
+				OkError{Ok: false},
+			},
+			expected: false,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.query.IsEmpty())
+		})
+	}
+}
@@ -29,6 +29,48 @@ func (q ReadResource) IsEmpty() bool {
 	return q.Resource == nil
 }
 
+// ReadResourcesBatch reads several resources in one document, with one aliased field per ID:
+// `r1: resource(id: $id1) r2: resource(id: $id2) ...`.
+type ReadResourcesBatch [][2]any
+
+func NewReadResourcesBatch(size int) ReadResourcesBatch {
+	batch := make(ReadResourcesBatch, 0, size)
+
+	for n := 1; n <= size; n++ {
+		batch = append(batch, [2]any{batchField("resource", n), &gqlResource{}})
+	}
+
+	return batch
+}
+
+func (q ReadResourcesBatch) IsEmpty() bool {
+	for _, res := range q.Resources() {
+		if res != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Resources returns the resources in the order of the IDs, nil for the missing ones.
+func (q ReadResourcesBatch) Resources() []*gqlResource {
+	resources := make([]*gqlResource, 0, len(q))
+
+	for _, field := range q {
+		res, ok := field[1].(*gqlResource)
+		if !ok || res == nil || res.ID == "" {
+			resources = append(resources, nil)
+
+			continue
+		}
+
+		resources = append(resources, res)
+	}
+
+	return resources
+}
+
 type gqlResource struct {
 	ResourceNode
 	Access Access `graphql:"access(after: $accessEndCursor, first: $pageLimit)"`
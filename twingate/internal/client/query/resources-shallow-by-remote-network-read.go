@@ -0,0 +1,9 @@
+package query
+
+type ReadShallowResourcesByRemoteNetwork struct {
+	ShallowResourcesWithType `graphql:"resources(filter: $filter, after: $resourcesEndCursor, first: $pageLimit)"`
+}
+
+func (q ReadShallowResourcesByRemoteNetwork) IsEmpty() bool {
+	return len(q.Edges) == 0
+}
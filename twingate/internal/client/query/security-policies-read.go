@@ -20,7 +20,7 @@ type SecurityPolicies struct {
 }
 
 type SecurityPolicyEdge struct {
-	Node *gqlSecurityPolicy
+	Node *gqlSecurityPolicyDetails
 }
 
 func (q ReadSecurityPolicies) ToModel() []*model.SecurityPolicy {
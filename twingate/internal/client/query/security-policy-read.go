@@ -5,7 +5,7 @@ import (
 )
 
 type ReadSecurityPolicy struct {
-	SecurityPolicy *gqlSecurityPolicy `graphql:"securityPolicy(id: $id, name: $name)"`
+	SecurityPolicy *gqlSecurityPolicyDetails `graphql:"securityPolicy(id: $id, name: $name)"`
 }
 
 func (q ReadSecurityPolicy) IsEmpty() bool {
@@ -16,6 +16,12 @@ type gqlSecurityPolicy struct {
 	IDName
 }
 
+type gqlSecurityPolicyDetails struct {
+	IDName
+	PolicyType string
+	Groups     gqlGroupIDs `graphql:"groups(after: $groupsEndCursor, first: $pageLimit)"`
+}
+
 func (q ReadSecurityPolicy) ToModel() *model.SecurityPolicy {
 	if q.SecurityPolicy == nil {
 		return nil
@@ -30,3 +36,25 @@ func (q *gqlSecurityPolicy) ToModel() *model.SecurityPolicy {
 		Name: q.Name,
 	}
 }
+
+func (q *gqlSecurityPolicyDetails) ToModel() *model.SecurityPolicy {
+	return &model.SecurityPolicy{
+		ID:         string(q.ID),
+		Name:       q.Name,
+		PolicyType: q.PolicyType,
+		Groups:     q.Groups.ToModel(),
+	}
+}
+
+type ReadSecurityPolicyGroups struct {
+	SecurityPolicy *gqlSecurityPolicyGroups `graphql:"securityPolicy(id: $id)"`
+}
+
+func (q ReadSecurityPolicyGroups) IsEmpty() bool {
+	return q.SecurityPolicy == nil
+}
+
+type gqlSecurityPolicyGroups struct {
+	IDName
+	Groups gqlGroupIDs `graphql:"groups(after: $groupsEndCursor, first: $pageLimit)"`
+}
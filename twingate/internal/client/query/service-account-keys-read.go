@@ -0,0 +1,78 @@
+package query
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+)
+
+type ReadServiceAccountKeys struct {
+	ServiceAccount *gqlServiceAccountKeys `graphql:"serviceAccount(id: $id)"`
+}
+
+func (q ReadServiceAccountKeys) IsEmpty() bool {
+	return q.ServiceAccount == nil
+}
+
+type gqlServiceAccountKeys struct {
+	IDName
+	Keys gqlServiceKeys `graphql:"keys(after: $keysEndCursor, first: $pageLimit)"`
+}
+
+type gqlServiceKeys struct {
+	PaginatedResource[*GqlServiceKeyEdge]
+}
+
+type GqlServiceKeyEdge struct {
+	Node *gqlServiceKeyDetails
+}
+
+type gqlServiceKeyDetails struct {
+	gqlServiceKey
+	CreatedAt string
+}
+
+func (q gqlServiceKeyDetails) ToModel() (*model.ServiceKey, error) {
+	serviceKey, err := q.gqlServiceKey.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	if q.CreatedAt != "" {
+		serviceKey.CreatedAt, err = time.Parse(time.RFC3339, q.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse creation time `%s`: %w", q.CreatedAt, err)
+		}
+	}
+
+	if q.ExpiresAt != "" {
+		// the expiration time was already validated by parseExpirationTime
+		expiresAt, _ := time.Parse(time.RFC3339, q.ExpiresAt)
+		serviceKey.ExpiresAt = &expiresAt
+	}
+
+	return serviceKey, nil
+}
+
+func (q ReadServiceAccountKeys) ToModel() ([]*model.ServiceKey, error) {
+	if q.ServiceAccount == nil {
+		return nil, nil //nolint
+	}
+
+	keys := make([]*model.ServiceKey, 0, len(q.ServiceAccount.Keys.Edges))
+
+	for _, edge := range utils.Filter(q.ServiceAccount.Keys.Edges, func(edge *GqlServiceKeyEdge) bool {
+		return edge != nil && edge.Node != nil
+	}) {
+		key, err := edge.Node.ToModel()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
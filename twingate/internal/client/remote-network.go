@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 )
 
 type (
@@ -137,3 +139,91 @@ func (client *Client) DeleteRemoteNetwork(ctx context.Context, remoteNetworkID s
 
 	return client.mutate(ctx, &response, newVars(gqlID(remoteNetworkID)), opr, attr{id: remoteNetworkID})
 }
+
+// ReadRemoteNetworkDependencies returns the Connectors, Resources and Gateways
+// that still belong to the Remote Network.
+func (client *Client) ReadRemoteNetworkDependencies(ctx context.Context, remoteNetworkID string) (*model.RemoteNetworkDependencies, error) {
+	opr := resourceRemoteNetwork.read().withCustomName("readRemoteNetworkDependencies")
+
+	if remoteNetworkID == "" {
+		return nil, opr.apiError(ErrGraphqlNetworkIDIsEmpty)
+	}
+
+	oprCtx := withOperationCtx(ctx, opr)
+	dependencies := &model.RemoteNetworkDependencies{}
+
+	connectors, err := client.ReadConnectors(oprCtx, "", "")
+	if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	dependencies.Connectors = utils.Filter(connectors, func(connector *model.Connector) bool {
+		return connector.NetworkID == remoteNetworkID
+	})
+
+	if err := client.readRemoteNetworkResources(oprCtx, remoteNetworkID, dependencies); err != nil {
+		return nil, err
+	}
+
+	gateways, err := client.ReadGateways(oprCtx)
+	if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+		return nil, err
+	}
+
+	dependencies.Gateways = utils.Filter(gateways, func(gateway *model.Gateway) bool {
+		return gateway.RemoteNetworkID == remoteNetworkID
+	})
+
+	return dependencies, nil
+}
+
+func (client *Client) readRemoteNetworkResources(ctx context.Context, remoteNetworkID string, dependencies *model.RemoteNetworkDependencies) error {
+	opr := resourceResource.read().withCustomName("readShallowResourcesByRemoteNetwork")
+
+	variables := newVars(
+		gqlNullable(query.NewResourceFilterInput("", "", nil, &remoteNetworkID), "filter"),
+		cursor(query.CursorResources),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadShallowResourcesByRemoteNetwork{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: remoteNetworkID}); err != nil {
+		if errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil
+		}
+
+		return err
+	}
+
+	if err := response.FetchPages(ctx, client.readShallowResourcesByRemoteNetworkAfter, variables); err != nil {
+		return err //nolint
+	}
+
+	for _, edge := range response.Edges {
+		id, name := string(edge.Node.ID), edge.Node.Name
+
+		switch edge.Node.Type {
+		case "SSHResource":
+			dependencies.SSHResources = append(dependencies.SSHResources, &model.SSHResource{ID: id, Name: name, RemoteNetworkID: remoteNetworkID})
+		case "KubernetesResource":
+			dependencies.KubernetesResources = append(dependencies.KubernetesResources, &model.KubernetesResource{ID: id, Name: name, RemoteNetworkID: remoteNetworkID})
+		default:
+			dependencies.Resources = append(dependencies.Resources, &model.Resource{ID: id, Name: name, RemoteNetworkID: remoteNetworkID})
+		}
+	}
+
+	return nil
+}
+
+func (client *Client) readShallowResourcesByRemoteNetworkAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.ShallowResourceEdge], error) {
+	opr := resourceResource.read().withCustomName("readShallowResourcesByRemoteNetwork")
+
+	variables[query.CursorResources] = cursor
+
+	response := query.ReadShallowResourcesByRemoteNetwork{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	return &response.PaginatedResource, nil
+}
@@ -3,7 +3,7 @@ package client
 import (
 	"context"
 	"errors"
-	"log"
+	"strings"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -177,11 +177,19 @@ func (client *Client) CreateResource(ctx context.Context, input *model.Resource)
 	)
 
 	response := query.CreateResource{}
-	if err := client.mutate(ctx, &response, variables, opr); err != nil {
+
+	createdID, err := client.create(ctx, &response, variables, opr, client.lookupCreatedResource(input))
+	if err != nil {
 		return nil, err
 	}
 
-	resource, err := response.Entity.ToModel()
+	var resource *model.Resource
+	if createdID != "" {
+		resource, err = client.ReadResource(ctx, createdID)
+	} else {
+		resource, err = response.Entity.ToModel()
+	}
+
 	if err != nil {
 		return nil, err //nolint:wrapcheck
 	}
@@ -205,6 +213,32 @@ func (client *Client) CreateResource(ctx context.Context, input *model.Resource)
 	return resource, nil
 }
 
+// lookupCreatedResource searches for the resources with the name, address, remote network and tags of the input.
+func (client *Client) lookupCreatedResource(input *model.Resource) lookupFunc {
+	return func(ctx context.Context) ([]string, error) {
+		filter := &model.ResourcesFilter{
+			Name:            &input.Name,
+			Tags:            input.Tags,
+			RemoteNetworkID: &input.RemoteNetworkID,
+		}
+
+		resources, err := client.readResourcesByName(ctx, filter)
+		if err != nil && !errors.Is(err, ErrGraphqlResultIsEmpty) {
+			return nil, err
+		}
+
+		var resourceIDs []string
+
+		for _, resource := range resources {
+			if resource.Address == input.Address {
+				resourceIDs = append(resourceIDs, resource.ID)
+			}
+		}
+
+		return resourceIDs, nil
+	}
+}
+
 func (client *Client) ReadResource(ctx context.Context, resourceID string) (*model.Resource, error) {
 	opr := resourceResource.read()
 
@@ -212,10 +246,24 @@ func (client *Client) ReadResource(ctx context.Context, resourceID string) (*mod
 		return nil, opr.apiError(ErrGraphqlIDIsEmpty)
 	}
 
-	if res, ok := getResource[*model.Resource](resourceID); ok {
+	if res, ok := getResource[*model.Resource](ctx, resourceID); ok {
 		return res, nil
 	}
 
+	// concurrent reads, e.g. during a refresh, are batched
+	res, err := client.resourceReads.read(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	setResource(ctx, res)
+
+	return res, nil
+}
+
+func (client *Client) readResource(ctx context.Context, resourceID string) (*model.Resource, error) {
+	opr := resourceResource.read()
+
 	variables := newVars(
 		gqlID(resourceID),
 		cursor(query.CursorAccess),
@@ -231,14 +279,48 @@ func (client *Client) ReadResource(ctx context.Context, resourceID string) (*mod
 		return nil, err //nolint
 	}
 
-	res, err := response.Resource.ToModel()
-	if err != nil {
-		return nil, err //nolint:wrapcheck
+	return response.Resource.ToModel() //nolint:wrapcheck
+}
+
+// readResourcesBatch reads the resources with aliased fields in one query. The resources that
+// are missing or fail to read are left out of the result.
+func (client *Client) readResourcesBatch(ctx context.Context, resourceIDs []string) (map[int]*model.Resource, error) {
+	opr := resourceResource.read().withCustomName("readResourcesBatch")
+
+	variables := newVars(
+		cursor(query.CursorAccess),
+		pageLimit(client.pageLimit),
+	)
+
+	for i, resourceID := range resourceIDs {
+		variables = gqlID(resourceID, query.BatchIDVariable(i+1))(variables)
 	}
 
-	setResource(res)
+	response := query.NewReadResourcesBatch(len(resourceIDs))
 
-	return res, nil
+	// on partial errors the response still holds the resources that were read
+	err := client.query(ctx, &response, variables, opr, attr{id: strings.Join(resourceIDs, ",")})
+
+	resources := make(map[int]*model.Resource, len(resourceIDs))
+
+	for i, gqlResource := range response.Resources() {
+		if gqlResource == nil {
+			continue
+		}
+
+		if err := gqlResource.Access.FetchPages(withOperationCtx(ctx, opr), client.readResourceAccessAfter, newVars(gqlID(resourceIDs[i]))); err != nil {
+			continue
+		}
+
+		res, err := gqlResource.ToModel()
+		if err != nil {
+			continue
+		}
+
+		resources[i] = res
+	}
+
+	return resources, err
 }
 
 func (client *Client) readResourceAccessAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.AccessEdge], error) {
@@ -295,7 +377,7 @@ func (client *Client) ReadFullResources(ctx context.Context) ([]*model.Resource,
 	variables := newVars(
 		cursor(query.CursorAccess),
 		cursor(query.CursorResources),
-		pageLimit(extendedPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadFullResources{}
@@ -304,18 +386,26 @@ func (client *Client) ReadFullResources(ctx context.Context) ([]*model.Resource,
 	}
 
 	oprCtx := withOperationCtx(ctx, opr)
+	resources := make([]*model.Resource, 0, len(response.Edges))
 
-	if err := response.FetchPages(oprCtx, client.readFullResourcesAfter, variables); err != nil {
-		return nil, err //nolint
-	}
+	for edge, err := range response.All(oprCtx, client.readFullResourcesAfter, variables, true) {
+		if err != nil {
+			return nil, err //nolint
+		}
 
-	for i := range response.Edges {
-		if err := response.Edges[i].Node.Access.FetchPages(oprCtx, client.readExtendedResourceAccessAfter, newVars(gqlID(response.Edges[i].Node.ID))); err != nil {
+		if err := edge.Node.Access.FetchPages(oprCtx, client.readExtendedResourceAccessAfter, newVars(gqlID(edge.Node.ID))); err != nil {
 			return nil, err //nolint:wrapcheck
 		}
+
+		resource, err := edge.Node.ToModel()
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		resources = append(resources, resource)
 	}
 
-	return response.ToModel() //nolint:wrapcheck
+	return resources, nil
 }
 
 func (client *Client) ReadFullResourcesByName(ctx context.Context, filter *model.ResourcesFilter) ([]*model.Resource, error) {
@@ -325,7 +415,7 @@ func (client *Client) ReadFullResourcesByName(ctx context.Context, filter *model
 		gqlNullable(query.NewResourceFilterInput(filter.GetName(), filter.GetFilterBy(), filter.GetTags(), filter.RemoteNetworkID), "filter"),
 		cursor(query.CursorAccess),
 		cursor(query.CursorResources),
-		pageLimit(extendedPageLimit),
+		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadFullResourcesByName{}
@@ -379,7 +469,7 @@ func (client *Client) readExtendedResourceAccessAfter(ctx context.Context, varia
 
 	resourceID := string(variables["id"].(graphql.ID))
 	variables[query.CursorAccess] = cursor
-	pageLimit(extendedPageLimit)(variables)
+	pageLimit(client.pageLimit)(variables)
 
 	response := query.ReadResourceAccess{}
 	if err := client.query(ctx, &response, variables, opr, attr{id: resourceID}); err != nil {
@@ -440,7 +530,7 @@ func (client *Client) UpdateResource(ctx context.Context, input *model.Resource)
 		resource.SecurityPolicyID = nil
 	}
 
-	setResource(resource)
+	setResource(ctx, resource)
 
 	return resource, nil
 }
@@ -475,25 +565,31 @@ func (client *Client) UpdateResourceActiveState(ctx context.Context, resource *m
 }
 
 func (client *Client) ReadResourcesByName(ctx context.Context, filter *model.ResourcesFilter) ([]*model.Resource, error) {
-	opr := resourceResource.read().withCustomName("readResourcesByName")
-
 	// cache is not used when cache filter config set or cache disabled
 	if isCacheReady[*model.Resource]() {
-		if matched := matchResources[*model.Resource](filter); len(matched) > 0 {
-			log.Printf(
-				"[DEBUG] ReadResourcesByName: matched #%d resources from cache: %v",
-				len(matched), utils.Map(matched, func(item *model.Resource) string {
+		if matched := matchResources[*model.Resource](ctx, filter); len(matched) > 0 {
+			logDebug(ctx, "ReadResourcesByName: matched resources from cache", map[string]any{
+				"count": len(matched),
+				"names": utils.Map(matched, func(item *model.Resource) string {
 					return item.Name
-				}))
+				}),
+			})
 
 			return matched, nil
 		}
 
-		log.Println("[DEBUG] ReadResourcesByName: no matched resource in cache: fallback to query API")
+		logDebug(ctx, "ReadResourcesByName: no matched resource in cache: fallback to query API")
 	} else {
-		log.Println("[DEBUG] ReadResourcesByName: cache is not ready: fallback to query API")
+		logDebug(ctx, "ReadResourcesByName: cache is not ready: fallback to query API")
 	}
 
+	return client.readResourcesByName(ctx, filter)
+}
+
+// readResourcesByName reads the resources matching the filter from the API, bypassing the cache.
+func (client *Client) readResourcesByName(ctx context.Context, filter *model.ResourcesFilter) ([]*model.Resource, error) {
+	opr := resourceResource.read().withCustomName("readResourcesByName")
+
 	variables := newVars(
 		gqlNullable(query.NewResourceFilterInput(filter.GetName(), filter.GetFilterBy(), filter.GetTags(), filter.RemoteNetworkID), "filter"),
 		cursor(query.CursorResources),
@@ -512,6 +608,37 @@ func (client *Client) ReadResourcesByName(ctx context.Context, filter *model.Res
 	return response.ToModel(), nil
 }
 
+func (client *Client) ReadFullResourcesByRemoteNetwork(ctx context.Context, remoteNetworkID string) ([]*model.Resource, error) {
+	opr := resourceResource.read().withCustomName("readFullResourcesByRemoteNetwork")
+
+	if remoteNetworkID == "" {
+		return nil, opr.apiError(ErrGraphqlNetworkIDIsEmpty)
+	}
+
+	filter := &model.ResourcesFilter{RemoteNetworkID: &remoteNetworkID}
+
+	// cache is not used when cache filter config set or cache disabled
+	if isCacheReady[*model.Resource]() {
+		if matched := matchResources[*model.Resource](ctx, filter); len(matched) > 0 {
+			logDebug(ctx, "ReadFullResourcesByRemoteNetwork: matched resources from cache", map[string]any{"count": len(matched)})
+
+			return matched, nil
+		}
+	}
+
+	return client.ReadFullResourcesByName(withOperationCtx(ctx, opr), filter)
+}
+
+// ReadCachedResourcesByRemoteNetwork returns the Resources of the given Remote Network stored in the cache.
+// It never calls the API and returns nothing when the resource cache is disabled.
+func (client *Client) ReadCachedResourcesByRemoteNetwork(ctx context.Context, remoteNetworkID string) []*model.Resource {
+	if remoteNetworkID == "" {
+		return nil
+	}
+
+	return matchResources[*model.Resource](ctx, &model.ResourcesFilter{RemoteNetworkID: &remoteNetworkID})
+}
+
 func (client *Client) readResourcesByNameAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.ResourceEdge], error) {
 	opr := resourceResource.read().withCustomName("readResourcesByName")
 
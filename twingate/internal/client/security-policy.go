@@ -6,6 +6,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hasura/go-graphql-client"
 )
 
 const queryReadSecurityPolicies = "readSecurityPolicies"
@@ -20,6 +21,8 @@ func (client *Client) ReadSecurityPolicy(ctx context.Context, securityPolicyID,
 	variables := newVars(
 		gqlID(securityPolicyID),
 		gqlNullable(securityPolicyName, "name"),
+		cursor(query.CursorGroups),
+		pageLimit(client.pageLimit),
 	)
 
 	response := query.ReadSecurityPolicy{}
@@ -27,6 +30,10 @@ func (client *Client) ReadSecurityPolicy(ctx context.Context, securityPolicyID,
 		return nil, err
 	}
 
+	if err := response.SecurityPolicy.Groups.FetchPages(withOperationCtx(ctx, opr), client.readSecurityPolicyGroupsAfter, newVars(gqlID(response.SecurityPolicy.ID))); err != nil {
+		return nil, err //nolint
+	}
+
 	return response.ToModel(), nil
 }
 
@@ -36,6 +43,7 @@ func (client *Client) ReadSecurityPolicies(ctx context.Context, name, filter str
 	variables := newVars(
 		gqlNullable(query.NewSecurityPolicyFilterField(name, filter), "filter"),
 		cursor(query.CursorPolicies),
+		cursor(query.CursorGroups),
 		pageLimit(client.pageLimit),
 	)
 
@@ -55,6 +63,14 @@ func (client *Client) ReadSecurityPolicies(ctx context.Context, name, filter str
 		return nil, opr.apiError(err)
 	}
 
+	oprCtx := withOperationCtx(ctx, opr)
+
+	for i := range response.Edges {
+		if err := response.Edges[i].Node.Groups.FetchPages(oprCtx, client.readSecurityPolicyGroupsAfter, newVars(gqlID(response.Edges[i].Node.ID))); err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+	}
+
 	return response.ToModel(), nil
 }
 
@@ -72,3 +88,18 @@ func (client *Client) readSecurityPoliciesAfter(ctx context.Context, variables m
 
 	return &response.PaginatedResource, nil
 }
+
+func (client *Client) readSecurityPolicyGroupsAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GroupIDEdge], error) {
+	opr := resourceSecurityPolicy.read().withCustomName("readSecurityPolicyGroupsAfter")
+
+	securityPolicyID := string(variables["id"].(graphql.ID))
+	variables[query.CursorGroups] = cursor
+	pageLimit(client.pageLimit)(variables)
+
+	response := query.ReadSecurityPolicyGroups{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: securityPolicyID}); err != nil {
+		return nil, err
+	}
+
+	return &response.SecurityPolicy.Groups.PaginatedResource, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 )
 
+const queryReadServiceKeys = "readServiceKeys"
+
 func (client *Client) CreateServiceKey(ctx context.Context, serviceAccountKey *model.ServiceKey) (*model.ServiceKey, error) {
 	opr := resourceServiceKey.create()
 
@@ -89,3 +91,43 @@ func (client *Client) RevokeServiceKey(ctx context.Context, serviceAccountKeyID
 
 	return client.mutate(ctx, &response, newVars(gqlID(serviceAccountKeyID)), opr, attr{id: serviceAccountKeyID})
 }
+
+func (client *Client) ReadServiceKeys(ctx context.Context, serviceAccountID string) ([]*model.ServiceKey, error) {
+	opr := resourceServiceKey.read().withCustomName(queryReadServiceKeys)
+
+	if serviceAccountID == "" {
+		return nil, opr.apiError(ErrGraphqlIDIsEmpty)
+	}
+
+	variables := newVars(
+		gqlID(serviceAccountID),
+		cursor(query.CursorServiceKeys),
+		pageLimit(client.pageLimit),
+	)
+
+	response := query.ReadServiceAccountKeys{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: serviceAccountID}); err != nil {
+		return nil, err
+	}
+
+	oprCtx := withOperationCtx(ctx, opr)
+
+	if err := response.ServiceAccount.Keys.FetchPages(oprCtx, client.readServiceKeysDetailsAfter, variables); err != nil {
+		return nil, err //nolint
+	}
+
+	return response.ToModel() //nolint
+}
+
+func (client *Client) readServiceKeysDetailsAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.GqlServiceKeyEdge], error) {
+	opr := resourceServiceKey.read().withCustomName(queryReadServiceKeys)
+
+	variables[query.CursorServiceKeys] = cursor
+
+	response := query.ReadServiceAccountKeys{}
+	if err := client.query(ctx, &response, variables, opr, attr{id: "All"}); err != nil {
+		return nil, err
+	}
+
+	return &response.ServiceAccount.Keys.PaginatedResource, nil
+}
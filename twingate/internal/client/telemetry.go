@@ -0,0 +1,384 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	EnvTelemetryExporter = "TWINGATE_TELEMETRY_EXPORTER"
+	EnvTelemetryEndpoint = "TWINGATE_TELEMETRY_ENDPOINT"
+	EnvTelemetryFilePath = "TWINGATE_TELEMETRY_FILE_PATH"
+
+	TelemetryExporterNone = "none"
+	TelemetryExporterOTLP = "otlp"
+	TelemetryExporterFile = "file"
+
+	instrumentationName = "github.com/Twingate/terraform-provider-twingate"
+	serviceName         = "terraform-provider-twingate"
+
+	otlpTracesPath  = "/v1/traces"
+	otlpMetricsPath = "/v1/metrics"
+
+	telemetryFilePermissions = 0o600
+
+	attrOperation     = "twingate.operation"
+	attrOperationType = "twingate.operation.type"
+	attrResourceType  = "twingate.resource.type"
+	attrResourceID    = "twingate.resource.id"
+	attrResourceName  = "twingate.resource.name"
+	attrPage          = "twingate.page"
+	attrAttempt       = "twingate.attempt"
+	attrRetry         = "twingate.retry"
+	attrStatus        = "twingate.status"
+	attrCorrelationID = "twingate.correlation_id"
+
+	attrRateLimiterWait = "twingate.rate_limiter.wait_seconds"
+
+	statusOK    = "ok"
+	statusError = "error"
+
+	retryEventName = "retry"
+)
+
+var (
+	ErrUnsupportedTelemetryExporter = errors.New("unsupported telemetry exporter")
+
+	//nolint:gochecknoglobals
+	TelemetryExporters = []string{TelemetryExporterNone, TelemetryExporterOTLP, TelemetryExporterFile}
+
+	//nolint:gochecknoglobals
+	telemetryShutdown = struct {
+		sync.Mutex
+		funcs []func(context.Context) error
+	}{}
+)
+
+// TelemetryOptions configures the export of the client's OpenTelemetry traces and metrics.
+type TelemetryOptions struct {
+	// Exporter is one of TelemetryExporters, telemetry is disabled when empty or "none".
+	Exporter string
+	// Endpoint is the base URL of the OTLP/HTTP collector, e.g. http://localhost:4318.
+	// When empty, the standard OTEL_EXPORTER_OTLP_* env vars are used.
+	Endpoint string
+	// FilePath is the file the spans and metrics are appended to as JSON lines.
+	FilePath string
+}
+
+func (o TelemetryOptions) enabled() bool {
+	return o.Exporter != "" && o.Exporter != TelemetryExporterNone
+}
+
+// SetupTelemetry installs the global OpenTelemetry tracer and meter providers used by the clients
+// created afterwards. It is a no-op when telemetry is disabled or already set up, e.g. by another
+// provider alias.
+func SetupTelemetry(ctx context.Context, opts TelemetryOptions, version string) error {
+	if !opts.enabled() {
+		return nil
+	}
+
+	telemetryShutdown.Lock()
+	defer telemetryShutdown.Unlock()
+
+	if len(telemetryShutdown.funcs) > 0 {
+		return nil
+	}
+
+	spanExporter, metricExporter, err := newTelemetryExporters(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	res := sdkresource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version),
+	)
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+	)
+
+	meterProvider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetMeterProvider(meterProvider)
+
+	telemetryShutdown.funcs = append(telemetryShutdown.funcs, tracerProvider.Shutdown, meterProvider.Shutdown)
+
+	return nil
+}
+
+// ShutdownTelemetry flushes and stops the exporters installed by SetupTelemetry.
+func ShutdownTelemetry(ctx context.Context) error {
+	telemetryShutdown.Lock()
+	defer telemetryShutdown.Unlock()
+
+	errs := make([]error, 0, len(telemetryShutdown.funcs))
+	for _, shutdown := range telemetryShutdown.funcs {
+		errs = append(errs, shutdown(ctx))
+	}
+
+	telemetryShutdown.funcs = nil
+
+	return errors.Join(errs...)
+}
+
+//nolint:ireturn
+func newTelemetryExporters(ctx context.Context, opts TelemetryOptions) (sdktrace.SpanExporter, sdkmetric.Exporter, error) {
+	switch opts.Exporter {
+	case TelemetryExporterOTLP:
+		return newOTLPExporters(ctx, opts.Endpoint)
+	case TelemetryExporterFile:
+		return newFileExporters(opts.FilePath)
+	default:
+		return nil, nil, fmt.Errorf("%w: %q", ErrUnsupportedTelemetryExporter, opts.Exporter)
+	}
+}
+
+//nolint:ireturn
+func newOTLPExporters(ctx context.Context, endpoint string) (sdktrace.SpanExporter, sdkmetric.Exporter, error) {
+	var (
+		traceOpts  []otlptracehttp.Option
+		metricOpts []otlpmetrichttp.Option
+	)
+
+	if endpoint != "" {
+		endpoint = strings.TrimSuffix(endpoint, "/")
+		traceOpts = append(traceOpts, otlptracehttp.WithEndpointURL(endpoint+otlpTracesPath))
+		metricOpts = append(metricOpts, otlpmetrichttp.WithEndpointURL(endpoint+otlpMetricsPath))
+	}
+
+	spanExporter, err := otlptracehttp.New(ctx, traceOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	metricExporter, err := otlpmetrichttp.New(ctx, metricOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+	}
+
+	return spanExporter, metricExporter, nil
+}
+
+//nolint:ireturn
+func newFileExporters(filePath string) (sdktrace.SpanExporter, sdkmetric.Exporter, error) {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, telemetryFilePermissions) // #nosec G304
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open telemetry file: %w", err)
+	}
+
+	// both exporters write to the same file
+	writer := &lockedWriter{writer: file}
+
+	spanExporter, err := stdouttrace.New(stdouttrace.WithWriter(writer))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create file trace exporter: %w", err)
+	}
+
+	metricExporter, err := stdoutmetric.New(stdoutmetric.WithWriter(writer))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create file metric exporter: %w", err)
+	}
+
+	return spanExporter, metricExporter, nil
+}
+
+type lockedWriter struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.writer.Write(p) //nolint:wrapcheck
+}
+
+// telemetry holds the client's tracer and metric instruments.
+type telemetry struct {
+	tracer            trace.Tracer
+	operationDuration metric.Float64Histogram
+	retries           metric.Int64Counter
+	rateLimiterWait   metric.Float64Histogram
+	correlationID     string
+}
+
+func newTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, correlationID string) *telemetry {
+	meter := meterProvider.Meter(instrumentationName)
+
+	// the instruments are valid no-ops when their creation fails
+	operationDuration, _ := meter.Float64Histogram("twingate.client.operation.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("The duration of the GraphQL operations, including the HTTP retries."))
+	retries, _ := meter.Int64Counter("twingate.client.retries",
+		metric.WithDescription("The number of retried GraphQL operations and HTTP requests."))
+	rateLimiterWait, _ := meter.Float64Histogram("twingate.client.rate_limiter.wait",
+		metric.WithUnit("s"),
+		metric.WithDescription("The time the GraphQL operations waited for the rate limiter."))
+
+	return &telemetry{
+		tracer:            tracerProvider.Tracer(instrumentationName),
+		operationDuration: operationDuration,
+		retries:           retries,
+		rateLimiterWait:   rateLimiterWait,
+		correlationID:     correlationID,
+	}
+}
+
+// startOperation starts the span of a GraphQL operation, it must be ended with endOperation.
+func (t *telemetry) startOperation(ctx context.Context, name string, opr operation, attrs ...attr) (context.Context, []attribute.KeyValue) {
+	oprAttrs := []attribute.KeyValue{
+		attribute.String(attrOperation, name),
+		attribute.String(attrOperationType, opr.name),
+		attribute.String(attrResourceType, opr.resource),
+	}
+
+	spanAttrs := make([]attribute.KeyValue, 0, len(oprAttrs)+5) //nolint:mnd
+	spanAttrs = append(spanAttrs, oprAttrs...)
+	spanAttrs = append(spanAttrs, attribute.String(attrCorrelationID, t.correlationID))
+
+	if len(attrs) > 0 && attrs[0].id != "" {
+		spanAttrs = append(spanAttrs, attribute.String(attrResourceID, attrs[0].id))
+	}
+
+	if len(attrs) > 0 && attrs[0].name != "" {
+		spanAttrs = append(spanAttrs, attribute.String(attrResourceName, attrs[0].name))
+	}
+
+	if page := query.PageFromCtx(ctx); page > 0 {
+		spanAttrs = append(spanAttrs, attribute.Int(attrPage, page))
+	}
+
+	if attempt := getAttemptFromCtx(ctx); attempt > 0 {
+		spanAttrs = append(spanAttrs, attribute.Int(attrAttempt, attempt))
+	}
+
+	ctx, _ = t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(spanAttrs...))
+
+	return withRetryCounterCtx(ctx), oprAttrs
+}
+
+// endOperation records the outcome of the operation started with startOperation.
+func (t *telemetry) endOperation(ctx context.Context, oprAttrs []attribute.KeyValue, start time.Time, err error) {
+	span := trace.SpanFromContext(ctx)
+	status := operationStatus(err)
+	statusAttr := attribute.String(attrStatus, status)
+
+	if retries := getRetryCounterFromCtx(ctx); retries > 0 {
+		span.SetAttributes(attribute.Int64(attrRetry, retries))
+		t.retries.Add(ctx, retries, metric.WithAttributes(oprAttrs...))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, status)
+	}
+
+	span.SetAttributes(statusAttr)
+	span.End()
+
+	t.operationDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(append(oprAttrs, statusAttr)...))
+}
+
+// recordRateLimiterWait records the time the operation waited for the rate limiter.
+func (t *telemetry) recordRateLimiterWait(ctx context.Context, oprAttrs []attribute.KeyValue, wait time.Duration) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Float64(attrRateLimiterWait, wait.Seconds()))
+	t.rateLimiterWait.Record(ctx, wait.Seconds(), metric.WithAttributes(oprAttrs...))
+}
+
+// recordQueryRetry counts a retry of the whole operation, e.g. after a timeout.
+func (t *telemetry) recordQueryRetry(ctx context.Context, opr operation) {
+	t.retries.Add(ctx, 1, metric.WithAttributes(
+		attribute.String(attrOperationType, opr.name),
+		attribute.String(attrResourceType, opr.resource),
+	))
+}
+
+// operationStatus returns "ok", the error class, e.g. "not found", or "error" for unclassified errors.
+func operationStatus(err error) string {
+	if err == nil {
+		return statusOK
+	}
+
+	if class := classifyError(err); class != nil {
+		return class.Error()
+	}
+
+	return statusError
+}
+
+// recordHTTPRetry is called by the retryable HTTP client before every retried request.
+func recordHTTPRetry(req *http.Request, retryNumber int) {
+	ctx := req.Context()
+
+	if counter, ok := ctx.Value(ctxRetryCounterKey).(*atomic.Int64); ok {
+		counter.Add(1)
+	}
+
+	trace.SpanFromContext(ctx).AddEvent(retryEventName, trace.WithAttributes(
+		attribute.Int(attrRetry, retryNumber),
+		attribute.String("url.full", SafeURL(req.URL.String())),
+	))
+}
+
+type ctxRetryCounterKeyType string
+
+const ctxRetryCounterKey ctxRetryCounterKeyType = "ctx_retry_counter_key"
+
+func withRetryCounterCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxRetryCounterKey, &atomic.Int64{})
+}
+
+func getRetryCounterFromCtx(ctx context.Context) int64 {
+	counter, ok := ctx.Value(ctxRetryCounterKey).(*atomic.Int64)
+	if !ok {
+		return 0
+	}
+
+	return counter.Load()
+}
+
+type ctxAttemptKeyType string
+
+const ctxAttemptKey ctxAttemptKeyType = "ctx_attempt_key"
+
+func withAttemptCtx(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, ctxAttemptKey, attempt)
+}
+
+func getAttemptFromCtx(ctx context.Context) int {
+	val, ok := ctx.Value(ctxAttemptKey).(int)
+	if !ok {
+		return 0
+	}
+
+	return val
+}
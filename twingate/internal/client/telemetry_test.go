@@ -0,0 +1,222 @@
+package client
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client/query"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type testTelemetry struct {
+	spans  *tracetest.InMemoryExporter
+	reader *sdkmetric.ManualReader
+}
+
+func newTelemetryTestClient(t *testing.T) (*Client, *testTelemetry) {
+	t.Helper()
+
+	spans := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+
+	client := newTestClient(t.Context())
+	client.telemetry = newTelemetry(
+		sdktrace.NewTracerProvider(sdktrace.WithSyncer(spans)),
+		sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+		client.correlationID,
+	)
+
+	httpmock.ActivateNonDefault(client.HTTPClient)
+	t.Cleanup(httpmock.DeactivateAndReset)
+
+	return client, &testTelemetry{spans: spans, reader: reader}
+}
+
+func (tt *testTelemetry) metric(t *testing.T, name string) *metricdata.Metrics {
+	t.Helper()
+
+	var data metricdata.ResourceMetrics
+
+	require.NoError(t, tt.reader.Collect(t.Context(), &data))
+
+	for _, scope := range data.ScopeMetrics {
+		for i := range scope.Metrics {
+			if scope.Metrics[i].Name == name {
+				return &scope.Metrics[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+func spanAttributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
+
+func TestTelemetryRecordsOperation(t *testing.T) {
+	client, tt := newTelemetryTestClient(t)
+
+	httpmock.RegisterResponder(http.MethodPost, client.GraphqlServerURL,
+		httpmock.NewStringResponder(http.StatusOK, `{"data": {"connector": {"id": "test-id", "name": "test-name"}}}`))
+
+	_, err := client.ReadConnector(WithCallerCtx(t.Context(), "resource"), "test-id")
+	require.NoError(t, err)
+
+	spans := tt.spans.GetSpans()
+	require.Len(t, spans, 1)
+
+	attrs := spanAttributes(spans[0])
+
+	assert.Equal(t, "resource_readConnector", spans[0].Name)
+	assert.Equal(t, "read", attrs[attrOperationType].AsString())
+	assert.Equal(t, "connector", attrs[attrResourceType].AsString())
+	assert.Equal(t, "test-id", attrs[attrResourceID].AsString())
+	assert.Equal(t, client.correlationID, attrs[attrCorrelationID].AsString())
+	assert.Equal(t, statusOK, attrs[attrStatus].AsString())
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+
+	duration := tt.metric(t, "twingate.client.operation.duration")
+	require.NotNil(t, duration)
+	assert.Len(t, duration.Data.(metricdata.Histogram[float64]).DataPoints, 1)
+
+	wait := tt.metric(t, "twingate.client.rate_limiter.wait")
+	require.NotNil(t, wait)
+	assert.Equal(t, uint64(1), wait.Data.(metricdata.Histogram[float64]).DataPoints[0].Count)
+}
+
+func TestTelemetryRecordsErrorStatus(t *testing.T) {
+	client, tt := newTelemetryTestClient(t)
+
+	httpmock.RegisterResponder(http.MethodPost, client.GraphqlServerURL,
+		httpmock.NewStringResponder(http.StatusOK, `{"data": {"connector": null}}`))
+
+	_, err := client.ReadConnector(t.Context(), "test-id")
+	require.Error(t, err)
+
+	spans := tt.spans.GetSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, ErrNotFound.Error(), spanAttributes(spans[0])[attrStatus].AsString())
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Len(t, spans[0].Events, 1)
+}
+
+func TestTelemetryRecordsPageNumber(t *testing.T) {
+	client, tt := newTelemetryTestClient(t)
+
+	httpmock.RegisterResponder(http.MethodPost, client.GraphqlServerURL,
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"connectors": {"pageInfo": {"endCursor": "cursor", "hasNextPage": true}, "edges": [{"node": {"id": "connector1"}}]}}}`),
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"connectors": {"pageInfo": {"hasNextPage": false}, "edges": [{"node": {"id": "connector2"}}]}}}`),
+		}))
+
+	connectors, err := client.ReadConnectors(t.Context(), "", "")
+	require.NoError(t, err)
+	assert.Len(t, connectors, 2)
+
+	spans := tt.spans.GetSpans()
+	require.Len(t, spans, 2)
+
+	_, firstPageHasNumber := spanAttributes(spans[0])[attrPage]
+	assert.False(t, firstPageHasNumber)
+	assert.Equal(t, int64(2), spanAttributes(spans[1])[attrPage].AsInt64())
+}
+
+func TestTelemetryRecordsHTTPRetries(t *testing.T) {
+	client, tt := newTelemetryTestClient(t)
+
+	ctx, oprAttrs := client.telemetry.startOperation(t.Context(), "readConnector", resourceConnector.read())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.GraphqlServerURL, nil)
+	require.NoError(t, err)
+
+	recordHTTPRetry(req, 1)
+	recordHTTPRetry(req, 2)
+
+	client.telemetry.endOperation(ctx, oprAttrs, time.Now(), nil)
+
+	spans := tt.spans.GetSpans()
+	require.Len(t, spans, 1)
+
+	assert.Equal(t, int64(2), spanAttributes(spans[0])[attrRetry].AsInt64())
+	assert.Len(t, spans[0].Events, 2)
+
+	retries := tt.metric(t, "twingate.client.retries")
+	require.NotNil(t, retries)
+	assert.Equal(t, int64(2), retries.Data.(metricdata.Sum[int64]).DataPoints[0].Value)
+}
+
+func TestTelemetryRecordsQueryAttempts(t *testing.T) {
+	client, tt := newTelemetryTestClient(t)
+	client.options.Query.MaxRetries = 1
+
+	httpmock.RegisterResponder(http.MethodPost, client.GraphqlServerURL,
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(http.StatusGatewayTimeout, ""),
+			httpmock.NewStringResponse(http.StatusOK, `{"data": {"connector": {"id": "test-id", "name": "test-name"}}}`),
+		}))
+
+	response := query.ReadConnector{}
+	err := client.queryWithTimeout(t.Context(), &response, newVars(gqlID("test-id")), resourceConnector.read(), attr{id: "test-id"})
+	require.NoError(t, err)
+
+	spans := tt.spans.GetSpans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, int64(1), spanAttributes(spans[0])[attrAttempt].AsInt64())
+	assert.Equal(t, ErrTransient.Error(), spanAttributes(spans[0])[attrStatus].AsString())
+	assert.Equal(t, int64(2), spanAttributes(spans[1])[attrAttempt].AsInt64())
+	assert.Equal(t, statusOK, spanAttributes(spans[1])[attrStatus].AsString())
+
+	retries := tt.metric(t, "twingate.client.retries")
+	require.NotNil(t, retries)
+	assert.Equal(t, int64(1), retries.Data.(metricdata.Sum[int64]).DataPoints[0].Value)
+}
+
+func TestSetupTelemetry(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		assert.NoError(t, SetupTelemetry(t.Context(), TelemetryOptions{Exporter: TelemetryExporterNone}, "test"))
+		assert.Empty(t, telemetryShutdown.funcs)
+	})
+
+	t.Run("unsupported exporter", func(t *testing.T) {
+		assert.ErrorIs(t, SetupTelemetry(t.Context(), TelemetryOptions{Exporter: "zipkin"}, "test"), ErrUnsupportedTelemetryExporter)
+	})
+
+	t.Run("file exporter", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "telemetry.jsonl")
+
+		require.NoError(t, SetupTelemetry(t.Context(), TelemetryOptions{Exporter: TelemetryExporterFile, FilePath: filePath}, "test"))
+		// the second setup is ignored
+		require.NoError(t, SetupTelemetry(t.Context(), TelemetryOptions{Exporter: TelemetryExporterFile, FilePath: filePath + ".ignored"}, "test"))
+
+		_, span := newTelemetry(otel.GetTracerProvider(), otel.GetMeterProvider(), "test").tracer.Start(t.Context(), "readConnector")
+		span.End()
+
+		require.NoError(t, ShutdownTelemetry(t.Context()))
+
+		content, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"Name":"readConnector"`)
+
+		assert.NoFileExists(t, filePath+".ignored")
+	})
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	EnvHTTPProxy      = "TWINGATE_HTTP_PROXY"
+	EnvNoProxy        = "TWINGATE_NO_PROXY"
+	EnvCABundleFile   = "TWINGATE_CA_BUNDLE_FILE"
+	EnvCABundle       = "TWINGATE_CA_BUNDLE"
+	EnvClientCertFile = "TWINGATE_CLIENT_CERT_FILE"
+	EnvClientKeyFile  = "TWINGATE_CLIENT_KEY_FILE"
+)
+
+var (
+	ErrInvalidProxyURL       = errors.New("invalid proxy URL")
+	ErrInvalidCABundle       = errors.New("CA bundle has no valid PEM certificates")
+	ErrClientCertKeyRequired = errors.New("client certificate and key must be set together")
+
+	// ErrTLSCertificate wraps the errors of the TLS certificate verification of the API server,
+	// e.g. when a TLS-inspecting proxy presents a certificate signed by an unknown authority.
+	ErrTLSCertificate = errors.New("TLS certificate verification failed")
+)
+
+// TransportOptions configures how the client connects to the API. The zero value uses
+// the proxy from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY env vars and the system CA pool.
+type TransportOptions struct {
+	// ProxyURL is the proxy used for all the requests, e.g. http://proxy.corp:3128.
+	ProxyURL string
+	// NoProxy is a comma-separated list of hosts, domains and CIDRs that are not proxied.
+	NoProxy string
+	// CABundleFile is a PEM file with CA certificates trusted in addition to the system ones.
+	CABundleFile string
+	// CABundle holds PEM CA certificates trusted in addition to the system ones.
+	CABundle string
+	// ClientCertFile and ClientKeyFile are the PEM certificate and key used for mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+// NewHTTPTransport returns the transport of the API clients configured with the options.
+func NewHTTPTransport(opts TransportOptions) (*http.Transport, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+
+	proxy, err := newProxyFunc(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	transport.Proxy = proxy
+
+	tlsConfig, err := newTLSConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+func newProxyFunc(opts TransportOptions) (func(*http.Request) (*url.URL, error), error) {
+	config := httpproxy.FromEnvironment()
+
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProxyURL, opts.ProxyURL)
+		}
+
+		config.HTTPProxy = opts.ProxyURL
+		config.HTTPSProxy = opts.ProxyURL
+	}
+
+	if opts.NoProxy != "" {
+		config.NoProxy = opts.NoProxy
+	}
+
+	proxyFunc := config.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, nil
+}
+
+func newTLSConfig(opts TransportOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if opts.CABundleFile != "" || opts.CABundle != "" {
+		rootCAs, err := newRootCAs(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if opts.ClientCertFile != "" || opts.ClientKeyFile != "" {
+		if opts.ClientCertFile == "" || opts.ClientKeyFile == "" {
+			return nil, ErrClientCertKeyRequired
+		}
+
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// newRootCAs returns the system CA pool with the certificates of the CA bundle added.
+func newRootCAs(opts TransportOptions) (*x509.CertPool, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+
+	if opts.CABundleFile != "" {
+		bundle, err := os.ReadFile(opts.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+
+		if !rootCAs.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCABundle, opts.CABundleFile)
+		}
+	}
+
+	if opts.CABundle != "" && !rootCAs.AppendCertsFromPEM([]byte(opts.CABundle)) {
+		return nil, ErrInvalidCABundle
+	}
+
+	return rootCAs, nil
+}
+
+// tlsCertificateError returns the error wrapped with ErrTLSCertificate if the request failed
+// to verify the certificate of the API server, or nil otherwise.
+func tlsCertificateError(err error) error {
+	var (
+		urlErr              *url.Error
+		verificationErr     *tls.CertificateVerificationError
+		unknownAuthorityErr x509.UnknownAuthorityError
+		hostnameErr         x509.HostnameError
+		invalidErr          x509.CertificateInvalidError
+	)
+
+	if !errors.As(err, &urlErr) {
+		return nil
+	}
+
+	// the certificate name errors aren't always typed, so we resort to matching on the error string
+	if certNameNotMatchMacErrorRe.MatchString(urlErr.Error()) ||
+		certNameNotMatchLinuxErrorRe.MatchString(urlErr.Error()) ||
+		errors.As(err, &verificationErr) ||
+		errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr) {
+		return fmt.Errorf("%w: %w", ErrTLSCertificate, urlErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func certificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+
+	return filePath
+}
+
+// newTestClientCertificate writes a self-signed client certificate and its key, and returns their paths.
+func newTestClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := writeTestFile(t, "client.crt", certificatePEM(cert))
+	keyFile := writeTestFile(t, "client.key", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})))
+
+	return cert, certFile, keyFile
+}
+
+func newTLSTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestNewHTTPTransportProxy(t *testing.T) {
+	cases := []struct {
+		name     string
+		opts     TransportOptions
+		url      string
+		expected string
+	}{
+		{
+			name:     "proxy",
+			opts:     TransportOptions{ProxyURL: "http://proxy.corp:3128"},
+			url:      "https://autoco.twingate.com/api/graphql/",
+			expected: "http://proxy.corp:3128",
+		},
+		{
+			name:     "no proxy",
+			opts:     TransportOptions{ProxyURL: "http://proxy.corp:3128", NoProxy: "internal.corp,.twingate.com"},
+			url:      "https://autoco.twingate.com/api/graphql/",
+			expected: "",
+		},
+		{
+			name:     "no proxy for other hosts",
+			opts:     TransportOptions{ProxyURL: "http://proxy.corp:3128", NoProxy: "internal.corp"},
+			url:      "https://autoco.twingate.com/api/graphql/",
+			expected: "http://proxy.corp:3128",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			transport, err := NewHTTPTransport(c.opts)
+			require.NoError(t, err)
+
+			reqURL, err := url.Parse(c.url)
+			require.NoError(t, err)
+
+			proxyURL, err := transport.Proxy(&http.Request{URL: reqURL})
+			require.NoError(t, err)
+
+			if c.expected == "" {
+				assert.Nil(t, proxyURL)
+			} else {
+				assert.Equal(t, c.expected, proxyURL.String())
+			}
+		})
+	}
+}
+
+func TestNewHTTPTransportErrors(t *testing.T) {
+	_, certFile, _ := newTestClientCertificate(t)
+
+	cases := []struct {
+		name     string
+		opts     TransportOptions
+		expected error
+	}{
+		{
+			name:     "invalid proxy URL",
+			opts:     TransportOptions{ProxyURL: "proxy.corp"},
+			expected: ErrInvalidProxyURL,
+		},
+		{
+			name:     "invalid CA bundle",
+			opts:     TransportOptions{CABundle: "not a certificate"},
+			expected: ErrInvalidCABundle,
+		},
+		{
+			name:     "invalid CA bundle file",
+			opts:     TransportOptions{CABundleFile: writeTestFile(t, "ca.pem", "not a certificate")},
+			expected: ErrInvalidCABundle,
+		},
+		{
+			name:     "missing CA bundle file",
+			opts:     TransportOptions{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")},
+			expected: os.ErrNotExist,
+		},
+		{
+			name:     "client certificate without key",
+			opts:     TransportOptions{ClientCertFile: certFile},
+			expected: ErrClientCertKeyRequired,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := NewHTTPTransport(c.opts)
+
+			assert.ErrorIs(t, err, c.expected)
+		})
+	}
+
+	t.Run("client certificate with a wrong key", func(t *testing.T) {
+		_, _, otherKeyFile := newTestClientCertificate(t)
+
+		_, err := NewHTTPTransport(TransportOptions{ClientCertFile: certFile, ClientKeyFile: otherKeyFile})
+
+		assert.ErrorContains(t, err, "failed to load client certificate")
+	})
+}
+
+func TestNewHTTPTransportCABundle(t *testing.T) {
+	server := newTLSTestServer(t)
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		transport, err := NewHTTPTransport(TransportOptions{})
+		require.NoError(t, err)
+
+		_, err = (&http.Client{Transport: transport}).Get(server.URL)
+
+		assert.ErrorIs(t, tlsCertificateError(err), ErrTLSCertificate)
+	})
+
+	t.Run("CA bundle", func(t *testing.T) {
+		transport, err := NewHTTPTransport(TransportOptions{CABundle: certificatePEM(server.Certificate())})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("CA bundle file", func(t *testing.T) {
+		transport, err := NewHTTPTransport(TransportOptions{CABundleFile: writeTestFile(t, "ca.pem", certificatePEM(server.Certificate()))})
+		require.NoError(t, err)
+
+		resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestNewHTTPTransportClientCertificate(t *testing.T) {
+	clientCert, certFile, keyFile := newTestClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	transport, err := NewHTTPTransport(TransportOptions{
+		CABundle:       certificatePEM(server.Certificate()),
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+	})
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestClientTLSCertificateErrorIsNotRetried(t *testing.T) {
+	server := newTLSTestServer(t)
+
+	transport, err := NewHTTPTransport(TransportOptions{})
+	require.NoError(t, err)
+
+	httpClient := NewCustomRetryableClient(time.Second, 3, transport, "xxxx", DefaultAgent, "test", "test-id")
+
+	resp, err := httpClient.Get(server.URL)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	assert.ErrorIs(t, err, ErrTLSCertificate)
+}
@@ -69,11 +69,17 @@ func (client *Client) ReadUsers(ctx context.Context, filter *UsersFilter) ([]*mo
 		return nil, err
 	}
 
-	if err := response.FetchPages(ctx, client.readUsersAfter, variables); err != nil {
-		return nil, err //nolint
+	users := make([]*model.User, 0, len(response.Edges))
+
+	for edge, err := range response.All(ctx, client.readUsersAfter, variables, true) {
+		if err != nil {
+			return nil, err //nolint
+		}
+
+		users = append(users, edge.Node.ToModel())
 	}
 
-	return response.ToModel(), nil
+	return users, nil
 }
 
 func (client *Client) readUsersAfter(ctx context.Context, variables map[string]any, cursor string) (*query.PaginatedResource[*query.UserEdge], error) {
@@ -21,6 +21,7 @@ var (
 
 	ErrLessThenMinDuration = errors.New("minimum duration is 1 hour")
 	ErrExceedsMaxDuration  = errors.New("maximum duration is 365 days")
+	ErrNegativeDuration    = errors.New("duration can't be negative")
 )
 
 const (
@@ -28,7 +29,10 @@ const (
 	maxDuration time.Duration = time.Hour * 24 * 365
 )
 
-type durationValidator struct{}
+type durationValidator struct {
+	// allowShort allows the durations shorter than 1 hour
+	allowShort bool
+}
 
 func (validator durationValidator) invalidUsageMessage() string {
 	return "string must be a valid duration"
@@ -48,7 +52,11 @@ func (v durationValidator) validate(value string) error {
 		return fmt.Errorf("failed to parse duration %w", err)
 	}
 
-	if duration < minDuration {
+	if duration < 0 {
+		return ErrNegativeDuration
+	}
+
+	if duration < minDuration && !v.allowShort {
 		return ErrLessThenMinDuration
 	}
 
@@ -95,3 +103,8 @@ func (v durationValidator) ValidateParameterString(ctx context.Context, request
 func Duration() durationValidator {
 	return durationValidator{}
 }
+
+// NonNegativeDuration returns a validator like Duration, without the minimum of 1 hour.
+func NonNegativeDuration() durationValidator {
+	return durationValidator{allowShort: true}
+}
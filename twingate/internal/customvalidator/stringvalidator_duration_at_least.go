@@ -0,0 +1,46 @@
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationAtLeastValidator{}
+
+type durationAtLeastValidator struct {
+	min time.Duration
+}
+
+func (v durationAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string must be a valid duration of at least %s, e.g. \"30s\" or \"2m\"", v.min)
+}
+
+func (v durationAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationAtLeastValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < v.min {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		))
+	}
+}
+
+// DurationAtLeast returns a validator which ensures that the duration string is at least the minimum.
+func DurationAtLeast(minDuration time.Duration) validator.String {
+	return durationAtLeastValidator{min: minDuration}
+}
@@ -0,0 +1,54 @@
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ipOrCIDRValidator{}
+
+type ipOrCIDRValidator struct{}
+
+func (v ipOrCIDRValidator) Description(_ context.Context) string {
+	return `string must be a valid IP or CIDR (like 10.0.0.0/16)`
+}
+
+func (v ipOrCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipOrCIDRValidator) validate(value string) error {
+	address, err := utils.ParseAddress(value)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if address.Type != utils.AddressTypeIP && address.Type != utils.AddressTypeCIDR {
+		return fmt.Errorf("invalid IP or CIDR: %q", value) //nolint:err113
+	}
+
+	return nil
+}
+
+func (v ipOrCIDRValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := v.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			err.Error(),
+		))
+	}
+}
+
+// IPOrCIDR returns a validator that ensures a string is a valid IP address or CIDR block.
+func IPOrCIDR() validator.String {
+	return ipOrCIDRValidator{}
+}
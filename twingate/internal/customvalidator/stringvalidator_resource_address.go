@@ -0,0 +1,41 @@
+package customvalidator
+
+import (
+	"context"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = resourceAddressValidator{}
+
+type resourceAddressValidator struct{}
+
+func (v resourceAddressValidator) Description(_ context.Context) string {
+	return `string must be a valid FQDN (like server.example.com), wildcard FQDN (like *.example.com), IP, CIDR (like 10.0.0.0/16) or IP range (like 10.0.0.1-10.0.0.50)`
+}
+
+func (v resourceAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v resourceAddressValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := utils.ParseAddress(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			err.Error(),
+		))
+	}
+}
+
+// ResourceAddress returns a validator that ensures a string is a valid Resource address:
+// FQDN, wildcard FQDN, IPv4/IPv6, CIDR or IP range.
+func ResourceAddress() validator.String {
+	return resourceAddressValidator{}
+}
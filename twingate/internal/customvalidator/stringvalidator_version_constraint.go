@@ -0,0 +1,40 @@
+package customvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = versionConstraintValidator{}
+
+type versionConstraintValidator struct{}
+
+func (v versionConstraintValidator) Description(_ context.Context) string {
+	return `string must be a valid version constraint (like ">= 1.60.0, < 1.70.0")`
+}
+
+func (v versionConstraintValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v versionConstraintValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := version.NewConstraint(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			err.Error(),
+		))
+	}
+}
+
+// VersionConstraint returns a validator that ensures a string is a valid semver constraint.
+func VersionConstraint() validator.String {
+	return versionConstraintValidator{}
+}
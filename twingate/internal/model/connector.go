@@ -1,11 +1,29 @@
 package model
 
-import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+import (
+	"slices"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/go-version"
+)
+
+const (
+	ConnectorStateAlive               = "ALIVE"
+	ConnectorStateDeadNoHeartbeat     = "DEAD_NO_HEARTBEAT"
+	ConnectorStateDeadHeartbeatTooOld = "DEAD_HEARTBEAT_TOO_OLD"
+	ConnectorStateDeadNoRelays        = "DEAD_NO_RELAYS"
+)
+
+//nolint:gochecknoglobals
+var ConnectorStates = []string{ConnectorStateAlive, ConnectorStateDeadNoHeartbeat, ConnectorStateDeadHeartbeatTooOld, ConnectorStateDeadNoRelays}
 
 type Connector struct {
 	ID                   string
 	Name                 string
 	NetworkID            string
+	NetworkType          string
 	StatusUpdatesEnabled *bool
 	State                string
 	Version              string
@@ -27,6 +45,7 @@ func (c Connector) ToTerraform() any {
 		attr.ID:                   c.ID,
 		attr.Name:                 c.Name,
 		attr.RemoteNetworkID:      c.NetworkID,
+		attr.RemoteNetworkType:    c.NetworkType,
 		attr.StatusUpdatesEnabled: *c.StatusUpdatesEnabled,
 		attr.State:                c.State,
 		attr.Version:              c.Version,
@@ -35,3 +54,64 @@ func (c Connector) ToTerraform() any {
 		attr.PrivateIPs:           c.PrivateIPs,
 	}
 }
+
+// ConnectorsFilter holds the Connector filters applied client-side, empty fields match everything.
+type ConnectorsFilter struct {
+	States            []string
+	Version           version.Constraints
+	RemoteNetworkID   string
+	RemoteNetworkType string
+	Hostname          string
+	IPAddress         *utils.Address
+}
+
+func (f *ConnectorsFilter) Match(connector *Connector) bool {
+	if f == nil {
+		return true
+	}
+
+	return f.matchState(connector) && f.matchVersion(connector) && f.matchRemoteNetwork(connector) &&
+		f.matchHostname(connector) && f.matchIPAddress(connector)
+}
+
+func (f *ConnectorsFilter) matchState(connector *Connector) bool {
+	return len(f.States) == 0 || slices.Contains(f.States, connector.State)
+}
+
+func (f *ConnectorsFilter) matchVersion(connector *Connector) bool {
+	if len(f.Version) == 0 {
+		return true
+	}
+
+	connectorVersion, err := version.NewVersion(connector.Version)
+	if err != nil {
+		// Connectors that never reported a valid version can't satisfy a constraint
+		return false
+	}
+
+	return f.Version.Check(connectorVersion)
+}
+
+func (f *ConnectorsFilter) matchRemoteNetwork(connector *Connector) bool {
+	return (f.RemoteNetworkID == "" || f.RemoteNetworkID == connector.NetworkID) &&
+		(f.RemoteNetworkType == "" || f.RemoteNetworkType == connector.NetworkType)
+}
+
+func (f *ConnectorsFilter) matchHostname(connector *Connector) bool {
+	return f.Hostname == "" || strings.EqualFold(f.Hostname, connector.Hostname)
+}
+
+func (f *ConnectorsFilter) matchIPAddress(connector *Connector) bool {
+	if f.IPAddress == nil {
+		return true
+	}
+
+	for _, ip := range append([]string{connector.PublicIP}, connector.PrivateIPs...) {
+		address, err := utils.ParseAddress(ip)
+		if err == nil && address.Type == utils.AddressTypeIP && f.IPAddress.Overlaps(address) {
+			return true
+		}
+	}
+
+	return false
+}
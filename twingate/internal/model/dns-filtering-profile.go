@@ -1,5 +1,16 @@
 package model
 
+import (
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+)
+
 const (
 	FallbackMethodAuto   = "AUTO"
 	FallbackMethodStrict = "STRICT"
@@ -57,3 +68,136 @@ func (p DNSFilteringProfile) GetName() string {
 func (p DNSFilteringProfile) GetID() string {
 	return p.ID
 }
+
+// priorityPrecision is the number of decimal places of the priorities stored by the API.
+const priorityPrecision = 100
+
+// RoundPriority rounds the priority to the precision of the API, so that e.g. 1.2000000000000002 equals 1.2.
+func RoundPriority(priority float64) float64 {
+	return math.Round(priority*priorityPrecision) / priorityPrecision
+}
+
+// DNSFilteringProfileOrder assigns priorities to profiles ordered from the highest to the lowest priority:
+// the last profile gets BasePriority and every preceding one PriorityStep more.
+type DNSFilteringProfileOrder struct {
+	ProfileIDs   []string
+	BasePriority float64
+	PriorityStep float64
+}
+
+// Priorities returns the priority of each ordered profile by its ID.
+func (o DNSFilteringProfileOrder) Priorities() map[string]float64 {
+	priorities := make(map[string]float64, len(o.ProfileIDs))
+
+	for i, id := range o.ProfileIDs {
+		priorities[id] = RoundPriority(o.BasePriority + o.PriorityStep*float64(len(o.ProfileIDs)-1-i))
+	}
+
+	return priorities
+}
+
+// Collisions returns the profiles outside the order whose priority equals a priority assigned by the order.
+func (o DNSFilteringProfileOrder) Collisions(profiles []*DNSFilteringProfile) []*DNSFilteringProfile {
+	priorities := o.Priorities()
+
+	assigned := make(map[float64]bool, len(priorities))
+	for _, priority := range priorities {
+		assigned[priority] = true
+	}
+
+	var collisions []*DNSFilteringProfile
+
+	for _, profile := range profiles {
+		if _, ordered := priorities[profile.ID]; !ordered && assigned[RoundPriority(profile.Priority)] {
+			collisions = append(collisions, profile)
+		}
+	}
+
+	return collisions
+}
+
+// DNSFilteringProfilesFilter holds the DNS filtering profile filters applied client-side, empty fields match everything.
+type DNSFilteringProfilesFilter struct {
+	Name           string
+	NameFilter     string
+	MinPriority    *float64
+	MaxPriority    *float64
+	FallbackMethod string
+}
+
+func (f *DNSFilteringProfilesFilter) Match(profile *DNSFilteringProfile) bool {
+	return f.MatchShallow(profile) && (f == nil || f.FallbackMethod == "" || f.FallbackMethod == profile.FallbackMethod)
+}
+
+// MatchShallow matches the name and the priority, which are the only filtered fields of the list of profiles.
+func (f *DNSFilteringProfilesFilter) MatchShallow(profile *DNSFilteringProfile) bool {
+	if f == nil {
+		return true
+	}
+
+	return f.matchName(profile) && f.matchPriority(profile)
+}
+
+func (f *DNSFilteringProfilesFilter) matchName(profile *DNSFilteringProfile) bool {
+	if f.Name == "" {
+		return true
+	}
+
+	switch f.NameFilter {
+	case attr.FilterByContains:
+		return strings.Contains(profile.Name, f.Name)
+	case attr.FilterByExclude:
+		return !strings.Contains(profile.Name, f.Name)
+	case attr.FilterByPrefix:
+		return strings.HasPrefix(profile.Name, f.Name)
+	case attr.FilterBySuffix:
+		return strings.HasSuffix(profile.Name, f.Name)
+	case attr.FilterByRegexp:
+		matched, err := regexp.MatchString(f.Name, profile.Name)
+
+		return err == nil && matched
+	default:
+		return profile.Name == f.Name
+	}
+}
+
+func (f *DNSFilteringProfilesFilter) matchPriority(profile *DNSFilteringProfile) bool {
+	return (f.MinPriority == nil || profile.Priority >= *f.MinPriority) &&
+		(f.MaxPriority == nil || profile.Priority <= *f.MaxPriority)
+}
+
+// DNSFilteringCoverage describes how groups are assigned to DNS filtering profiles.
+type DNSFilteringCoverage struct {
+	// OverlappingGroups maps the groups assigned to more than one profile to the sorted IDs of those profiles.
+	OverlappingGroups map[string][]string
+	// UncoveredGroups are the sorted IDs of the active groups not assigned to any profile.
+	UncoveredGroups []string
+}
+
+func NewDNSFilteringCoverage(profiles []*DNSFilteringProfile, activeGroups []string) *DNSFilteringCoverage {
+	groupProfiles := make(map[string][]string)
+
+	for _, profile := range profiles {
+		for _, group := range profile.Groups {
+			groupProfiles[group] = append(groupProfiles[group], profile.ID)
+		}
+	}
+
+	coverage := &DNSFilteringCoverage{
+		OverlappingGroups: make(map[string][]string),
+		UncoveredGroups: utils.Filter(activeGroups, func(group string) bool {
+			return len(groupProfiles[group]) == 0
+		}),
+	}
+
+	for group, profileIDs := range groupProfiles {
+		if len(profileIDs) > 1 {
+			slices.Sort(profileIDs)
+			coverage.OverlappingGroups[group] = profileIDs
+		}
+	}
+
+	sort.Strings(coverage.UncoveredGroups)
+
+	return coverage
+}
@@ -1,6 +1,10 @@
 package model
 
-import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+import (
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+)
 
 const (
 	LocationAWS         = "AWS"
@@ -15,6 +19,8 @@ const (
 
 var Locations = []string{LocationAWS, LocationAzure, LocationGoogleCloud, LocationOnPremise, LocationOther} //nolint
 
+var NetworkTypes = []string{NetworkTypeRegular, NetworkTypeExit} //nolint
+
 type RemoteNetwork struct {
 	ID       string
 	Name     string
@@ -35,5 +41,47 @@ func (n RemoteNetwork) ToTerraform() any {
 		attr.ID:       n.ID,
 		attr.Name:     n.Name,
 		attr.Location: n.Location,
+		attr.Type:     n.Type,
+	}
+}
+
+// RemoteNetworkDependencies lists the objects that still belong to a Remote Network.
+type RemoteNetworkDependencies struct {
+	Connectors          []*Connector
+	Resources           []*Resource
+	SSHResources        []*SSHResource
+	KubernetesResources []*KubernetesResource
+	Gateways            []*Gateway
+}
+
+func (d RemoteNetworkDependencies) IsEmpty() bool {
+	return len(d.Connectors) == 0 && len(d.Resources) == 0 && len(d.SSHResources) == 0 &&
+		len(d.KubernetesResources) == 0 && len(d.Gateways) == 0
+}
+
+// Describe returns one line per dependent object, e.g. `connector "my-connector" (Q29ubmVjdG9yOjE=)`.
+func (d RemoteNetworkDependencies) Describe() []string {
+	lines := make([]string, 0, len(d.Connectors)+len(d.Resources)+len(d.SSHResources)+len(d.KubernetesResources)+len(d.Gateways))
+
+	for _, connector := range d.Connectors {
+		lines = append(lines, fmt.Sprintf("connector %q (%s)", connector.Name, connector.ID))
+	}
+
+	for _, resource := range d.Resources {
+		lines = append(lines, fmt.Sprintf("resource %q (%s)", resource.Name, resource.ID))
+	}
+
+	for _, resource := range d.SSHResources {
+		lines = append(lines, fmt.Sprintf("SSH resource %q (%s)", resource.Name, resource.ID))
 	}
+
+	for _, resource := range d.KubernetesResources {
+		lines = append(lines, fmt.Sprintf("Kubernetes resource %q (%s)", resource.Name, resource.ID))
+	}
+
+	for _, gateway := range d.Gateways {
+		lines = append(lines, fmt.Sprintf("gateway %q (%s)", gateway.Address, gateway.ID))
+	}
+
+	return lines
 }
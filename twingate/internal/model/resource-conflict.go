@@ -0,0 +1,217 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+)
+
+const (
+	ConflictTypeAddressOverlap   = "ADDRESS_OVERLAP"
+	ConflictTypeShadowedWildcard = "SHADOWED_WILDCARD"
+	ConflictTypePortCollision    = "PORT_COLLISION"
+
+	minPort = 1
+	maxPort = 65535
+)
+
+//nolint:gochecknoglobals
+var ConflictTypes = []string{ConflictTypeAddressOverlap, ConflictTypeShadowedWildcard, ConflictTypePortCollision}
+
+// ResourceConflict describes two Resources of the same Remote Network whose addresses
+// are resolved against each other by Twingate.
+type ResourceConflict struct {
+	Type                       string
+	ResourceID                 string
+	ResourceAddress            string
+	ConflictingResourceID      string
+	ConflictingResourceAddress string
+	Description                string
+}
+
+// FindResourceConflicts returns address overlaps, shadowed wildcard entries and
+// port range collisions with differing access groups between the given Resources.
+func FindResourceConflicts(resources []*Resource) []ResourceConflict {
+	sorted := slices.Clone(resources)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	addresses := make([]*utils.Address, len(sorted))
+
+	for i, res := range sorted {
+		// addresses are validated by the API, skip the ones we can't parse
+		addresses[i], _ = utils.ParseAddress(res.Address)
+	}
+
+	var conflicts []ResourceConflict
+
+	for i := range sorted {
+		for j := i + 1; j < len(sorted); j++ {
+			if addresses[i] == nil || addresses[j] == nil || sorted[i].RemoteNetworkID != sorted[j].RemoteNetworkID {
+				continue
+			}
+
+			conflict := findAddressConflict(sorted[i], sorted[j], addresses[i], addresses[j])
+			if conflict == nil {
+				continue
+			}
+
+			conflicts = append(conflicts, *conflict)
+
+			if collision := findPortCollision(sorted[i], sorted[j]); collision != "" {
+				conflicts = append(conflicts, ResourceConflict{
+					Type:                       ConflictTypePortCollision,
+					ResourceID:                 conflict.ResourceID,
+					ResourceAddress:            conflict.ResourceAddress,
+					ConflictingResourceID:      conflict.ConflictingResourceID,
+					ConflictingResourceAddress: conflict.ConflictingResourceAddress,
+					Description:                collision,
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+func findAddressConflict(resA, resB *Resource, addrA, addrB *utils.Address) *ResourceConflict {
+	// the parsed hostnames are normalized, equal IP based addresses overlap
+	if !addrA.IsIPBased() && addrA.Value == addrB.Value {
+		return &ResourceConflict{
+			Type:                       ConflictTypeAddressOverlap,
+			ResourceID:                 resA.ID,
+			ResourceAddress:            resA.Address,
+			ConflictingResourceID:      resB.ID,
+			ConflictingResourceAddress: resB.Address,
+			Description:                fmt.Sprintf("address %s is the same as address %s", resA.Address, resB.Address),
+		}
+	}
+
+	if addrA.Overlaps(addrB) {
+		return &ResourceConflict{
+			Type:                       ConflictTypeAddressOverlap,
+			ResourceID:                 resA.ID,
+			ResourceAddress:            resA.Address,
+			ConflictingResourceID:      resB.ID,
+			ConflictingResourceAddress: resB.Address,
+			Description:                fmt.Sprintf("address %s overlaps with address %s", resA.Address, resB.Address),
+		}
+	}
+
+	// the wildcard Resource is reported as shadowed by the more specific one
+	wildcard, specific := resA, resB
+	if !shadows(addrB, addrA) {
+		if !shadows(addrA, addrB) {
+			return nil
+		}
+
+		wildcard, specific = resB, resA
+	}
+
+	return &ResourceConflict{
+		Type:                       ConflictTypeShadowedWildcard,
+		ResourceID:                 wildcard.ID,
+		ResourceAddress:            wildcard.Address,
+		ConflictingResourceID:      specific.ID,
+		ConflictingResourceAddress: specific.Address,
+		Description:                fmt.Sprintf("wildcard address %s is shadowed by address %s", wildcard.Address, specific.Address),
+	}
+}
+
+// shadows reports whether the specific address is matched by the wildcard address. Equal addresses
+// are reported as overlapping instead.
+func shadows(specific, wildcard *utils.Address) bool {
+	if wildcard.Type != utils.AddressTypeWildcardFQDN || specific.IsIPBased() || specific.Value == wildcard.Value {
+		return false
+	}
+
+	pattern := regexp.QuoteMeta(wildcard.Value)
+	pattern = strings.NewReplacer(`\*`, `.*`, `\?`, `.`).Replace(pattern)
+
+	matched, err := regexp.MatchString("^"+pattern+"$", specific.Value)
+
+	return err == nil && matched
+}
+
+func findPortCollision(resA, resB *Resource) string {
+	if equalGroups(resA.GroupsAccess, resB.GroupsAccess) {
+		return ""
+	}
+
+	protocolsA, protocolsB := resA.Protocols, resB.Protocols
+	if protocolsA == nil {
+		protocolsA = DefaultProtocols()
+	}
+
+	if protocolsB == nil {
+		protocolsB = DefaultProtocols()
+	}
+
+	var collisions []string
+
+	if ports := overlappingPorts(protocolsA.TCP, protocolsB.TCP); ports != "" {
+		collisions = append(collisions, "tcp "+ports)
+	}
+
+	if ports := overlappingPorts(protocolsA.UDP, protocolsB.UDP); ports != "" {
+		collisions = append(collisions, "udp "+ports)
+	}
+
+	if len(collisions) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("ports %s are reachable through both Resources with differing access groups", strings.Join(collisions, ", "))
+}
+
+func equalGroups(accessA, accessB []AccessGroup) bool {
+	groupsA := utils.MakeLookupMap(utils.Map(accessA, func(item AccessGroup) string { return item.GroupID }))
+	groupsB := utils.MakeLookupMap(utils.Map(accessB, func(item AccessGroup) string { return item.GroupID }))
+
+	if len(groupsA) != len(groupsB) {
+		return false
+	}
+
+	for id := range groupsA {
+		if !groupsB[id] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// overlappingPorts returns the port ranges allowed by both protocols, e.g. "80, 8000-8080".
+func overlappingPorts(protocolA, protocolB *Protocol) string {
+	rangesA, rangesB := allowedPorts(protocolA), allowedPorts(protocolB)
+
+	var overlaps []string
+
+	for _, portA := range rangesA {
+		for _, portB := range rangesB {
+			start, end := max(portA.Start, portB.Start), min(portA.End, portB.End)
+			if start <= end {
+				overlaps = append(overlaps, PortRange{Start: start, End: end}.String())
+			}
+		}
+	}
+
+	return strings.Join(overlaps, ", ")
+}
+
+func allowedPorts(protocol *Protocol) []*PortRange {
+	if protocol == nil || protocol.Policy == PolicyAllowAll {
+		return []*PortRange{{Start: minPort, End: maxPort}}
+	}
+
+	if protocol.Policy == PolicyRestricted {
+		return protocol.Ports
+	}
+
+	return nil
+}
@@ -1,10 +1,16 @@
 package model
 
-import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+import (
+	"sort"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+)
 
 type SecurityPolicy struct {
-	ID   string
-	Name string
+	ID         string
+	Name       string
+	PolicyType string
+	Groups     []string
 }
 
 func (s SecurityPolicy) ToTerraform() any {
@@ -13,3 +19,49 @@ func (s SecurityPolicy) ToTerraform() any {
 		attr.Name: s.Name,
 	}
 }
+
+// SecurityPolicyAccessGroup is a group access entry of a Resource referencing a Security Policy.
+type SecurityPolicyAccessGroup struct {
+	ResourceID string
+	GroupID    string
+}
+
+// SecurityPolicyUsage lists the Resources and the group access entries that explicitly reference a Security Policy.
+type SecurityPolicyUsage struct {
+	ResourceIDs  []string
+	AccessGroups []SecurityPolicyAccessGroup
+}
+
+// NewSecurityPolicyUsage finds the references to the policy in the resources, sorted by resource and group ID.
+func NewSecurityPolicyUsage(policyID string, resources []*Resource) *SecurityPolicyUsage {
+	usage := &SecurityPolicyUsage{
+		ResourceIDs:  []string{},
+		AccessGroups: []SecurityPolicyAccessGroup{},
+	}
+
+	for _, resource := range resources {
+		if resource.SecurityPolicyID != nil && *resource.SecurityPolicyID == policyID {
+			usage.ResourceIDs = append(usage.ResourceIDs, resource.ID)
+		}
+
+		for _, access := range resource.GroupsAccess {
+			if access.SecurityPolicyID != nil && *access.SecurityPolicyID == policyID {
+				usage.AccessGroups = append(usage.AccessGroups, SecurityPolicyAccessGroup{
+					ResourceID: resource.ID,
+					GroupID:    access.GroupID,
+				})
+			}
+		}
+	}
+
+	sort.Strings(usage.ResourceIDs)
+	sort.Slice(usage.AccessGroups, func(i, j int) bool {
+		if usage.AccessGroups[i].ResourceID != usage.AccessGroups[j].ResourceID {
+			return usage.AccessGroups[i].ResourceID < usage.AccessGroups[j].ResourceID
+		}
+
+		return usage.AccessGroups[i].GroupID < usage.AccessGroups[j].GroupID
+	})
+
+	return usage
+}
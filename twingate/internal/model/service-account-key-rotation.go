@@ -0,0 +1,74 @@
+package model
+
+import "time"
+
+// ServiceKeyRotation describes the generations of keys kept for a Service Account.
+// Keys are ordered from the newest (current) to the oldest one.
+type ServiceKeyRotation struct {
+	RotationPeriod time.Duration
+	Overlap        time.Duration
+	Generations    int
+	RotatedAt      time.Time
+	Keys           []*ServiceKey
+}
+
+// Current returns the most recently created key, or nil if there are no keys.
+func (r *ServiceKeyRotation) Current() *ServiceKey {
+	if len(r.Keys) == 0 {
+		return nil
+	}
+
+	return r.Keys[0]
+}
+
+// Previous returns the key replaced by the last rotation, or nil if there is none.
+func (r *ServiceKeyRotation) Previous() *ServiceKey {
+	if len(r.Keys) < 2 { //nolint:mnd
+		return nil
+	}
+
+	return r.Keys[1]
+}
+
+// NeedsRotation reports whether a new key must be created: there is no active current key
+// or the rotation period has elapsed since the last rotation.
+func (r *ServiceKeyRotation) NeedsRotation(now time.Time) bool {
+	current := r.Current()
+	if current == nil || !current.IsActive() || r.RotatedAt.IsZero() {
+		return true
+	}
+
+	return !now.Before(r.RotatedAt.Add(r.RotationPeriod))
+}
+
+// KeysToRevoke returns the active keys, other than the current one, whose overlap window has ended.
+func (r *ServiceKeyRotation) KeysToRevoke(now time.Time) []*ServiceKey {
+	var keys []*ServiceKey
+
+	for i, key := range r.Keys {
+		if i == 0 || !key.IsActive() {
+			continue
+		}
+
+		// older generations are always past their overlap window
+		if i > 1 || !now.Before(r.RotatedAt.Add(r.Overlap)) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// KeysToDelete returns the keys exceeding the number of generations to keep.
+func (r *ServiceKeyRotation) KeysToDelete() []*ServiceKey {
+	if r.Generations <= 0 || len(r.Keys) <= r.Generations {
+		return nil
+	}
+
+	return r.Keys[r.Generations:]
+}
+
+// NeedsUpdate reports whether the next apply has to rotate, revoke or delete keys.
+func (r *ServiceKeyRotation) NeedsUpdate(now time.Time) bool {
+	return r.NeedsRotation(now) || len(r.KeysToRevoke(now)) > 0 || len(r.KeysToDelete()) > 0
+}
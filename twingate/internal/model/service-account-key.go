@@ -1,10 +1,16 @@
 package model
 
+import "time"
+
 const (
 	StatusActive  = "ACTIVE"
 	StatusRevoked = "REVOKED"
+	StatusExpired = "EXPIRED"
 )
 
+//nolint:gochecknoglobals
+var ServiceKeyStatuses = []string{StatusActive, StatusRevoked, StatusExpired}
+
 type ServiceKey struct {
 	ID             string
 	Name           string
@@ -12,6 +18,8 @@ type ServiceKey struct {
 	Service        string
 	ExpirationTime int
 	Token          string
+	CreatedAt      time.Time
+	ExpiresAt      *time.Time
 }
 
 func (s ServiceKey) GetName() string {
@@ -25,3 +33,20 @@ func (s ServiceKey) GetID() string {
 func (s ServiceKey) IsActive() bool {
 	return s.Status == StatusActive
 }
+
+// DaysUntilExpiry returns the number of whole days left until the key expires,
+// negative for expired keys, or nil if the key never expires.
+func (s ServiceKey) DaysUntilExpiry(now time.Time) *int {
+	if s.ExpiresAt == nil {
+		return nil
+	}
+
+	days := int(s.ExpiresAt.Sub(now).Hours() / hoursInDay)
+
+	return &days
+}
+
+// ExpiresWithin reports whether the key expires before the given window from now has passed.
+func (s ServiceKey) ExpiresWithin(now time.Time, window time.Duration) bool {
+	return s.ExpiresAt != nil && s.ExpiresAt.Before(now.Add(window))
+}
@@ -1,6 +1,11 @@
 package model
 
-import "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+import (
+	"slices"
+	"sort"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+)
 
 const (
 	UserRoleAdmin          = "ADMIN"
@@ -21,8 +26,9 @@ const DefaultUserRole = UserRoleMember
 
 //nolint:gochecknoglobals
 var (
-	UserRoles = []string{UserRoleAdmin, UserRoleDevops, UserRoleSupport, UserRoleMember, UserRoleAccessReviewer}
-	UserTypes = []string{UserTypeManual, UserTypeSynced}
+	UserRoles  = []string{UserRoleAdmin, UserRoleDevops, UserRoleSupport, UserRoleMember, UserRoleAccessReviewer}
+	UserTypes  = []string{UserTypeManual, UserTypeSynced}
+	UserStates = []string{UserStateActive, UserStateDisabled}
 )
 
 type User struct {
@@ -33,6 +39,8 @@ type User struct {
 	Role      string
 	Type      string
 	IsActive  bool
+	// Groups holds the IDs of the non-system groups the user is a member of, it's set only by AssignUserGroups.
+	Groups []string
 }
 
 func (u User) GetID() string {
@@ -82,3 +90,101 @@ func (u UserUpdate) State() string {
 
 	return UserStateDisabled
 }
+
+// AssignUserGroups sets the groups of the users from the group memberships. System groups,
+// like Everyone, are skipped as every user is a member of them.
+func AssignUserGroups(users []*User, groups []*Group) {
+	userGroups := make(map[string][]string)
+
+	for _, group := range groups {
+		if group.Type == GroupTypeSystem {
+			continue
+		}
+
+		for _, userID := range group.Users {
+			userGroups[userID] = append(userGroups[userID], group.ID)
+		}
+	}
+
+	for _, user := range users {
+		user.Groups = userGroups[user.ID]
+		if user.Groups == nil {
+			user.Groups = []string{}
+		}
+
+		sort.Strings(user.Groups)
+	}
+}
+
+// UsersFilter holds the User filters applied client-side, empty fields match everything.
+type UsersFilter struct {
+	State         string
+	Type          string
+	MemberOfGroup string
+	NotInAnyGroup bool
+}
+
+func (f *UsersFilter) Match(user *User) bool {
+	if f == nil {
+		return true
+	}
+
+	return (f.State == "" || f.State == user.State()) &&
+		(f.Type == "" || f.Type == user.Type) &&
+		(f.MemberOfGroup == "" || slices.Contains(user.Groups, f.MemberOfGroup)) &&
+		(!f.NotInAnyGroup || len(user.Groups) == 0)
+}
+
+// UserGroupChanges returns, by group ID, the users to add to and to remove from the group to move
+// from the current group memberships to the planned ones. Users without an ID are skipped.
+func UserGroupChanges(current, planned []*User) (map[string][]string, map[string][]string) {
+	currentGroups := userGroupsByID(current)
+	plannedGroups := userGroupsByID(planned)
+
+	added := make(map[string][]string)
+	removed := make(map[string][]string)
+
+	for userID, groups := range plannedGroups {
+		for group := range groups {
+			if !currentGroups[userID][group] {
+				added[group] = append(added[group], userID)
+			}
+		}
+	}
+
+	for userID, groups := range currentGroups {
+		for group := range groups {
+			if !plannedGroups[userID][group] {
+				removed[group] = append(removed[group], userID)
+			}
+		}
+	}
+
+	for _, users := range added {
+		sort.Strings(users)
+	}
+
+	for _, users := range removed {
+		sort.Strings(users)
+	}
+
+	return added, removed
+}
+
+func userGroupsByID(users []*User) map[string]map[string]bool {
+	groups := make(map[string]map[string]bool, len(users))
+
+	for _, user := range users {
+		if user.ID == "" {
+			continue
+		}
+
+		groups[user.ID] = make(map[string]bool, len(user.Groups))
+
+		for _, group := range user.Groups {
+			groups[user.ID][group] = true
+		}
+	}
+
+	return groups
+}
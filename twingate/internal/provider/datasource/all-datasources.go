@@ -11,10 +11,14 @@ const (
 	TwingateConnectors               = "twingate_connectors"
 	TwingateResource                 = "twingate_resource"
 	TwingateResources                = "twingate_resources"
+	TwingateResourceConflicts        = "twingate_resource_conflicts"
 	TwingateServiceAccounts          = "twingate_service_accounts"
+	TwingateServiceAccountKeys       = "twingate_service_account_keys"
 	TwingateSecurityPolicy           = "twingate_security_policy" // #nosec G101
 	TwingateSecurityPolicies         = "twingate_security_policies"
 	TwingateDNSFilteringProfile      = "twingate_dns_filtering_profile"
+	TwingateDNSFilteringProfiles     = "twingate_dns_filtering_profiles"
+	TwingateDNSFilteringDomainList   = "twingate_dns_filtering_domain_list"
 	TwingateX509CertificateAuthority = "twingate_x509_certificate_authority"
 	TwingateSSHCertificateAuthority  = "twingate_ssh_certificate_authority"
 	TwingateGateway                  = "twingate_gateway"
@@ -6,6 +6,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -28,6 +29,7 @@ type connectorModel struct {
 	ID                   types.String `tfsdk:"id"`
 	Name                 types.String `tfsdk:"name"`
 	RemoteNetworkID      types.String `tfsdk:"remote_network_id"`
+	RemoteNetworkType    types.String `tfsdk:"remote_network_type"`
 	StatusUpdatesEnabled types.Bool   `tfsdk:"status_updates_enabled"`
 	State                types.String `tfsdk:"state"`
 	Hostname             types.String `tfsdk:"hostname"`
@@ -75,6 +77,10 @@ func (d *connector) Schema(ctx context.Context, req datasource.SchemaRequest, re
 				Computed:    true,
 				Description: "The ID of the Remote Network the Connector is attached to.",
 			},
+			attr.RemoteNetworkType: schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the Remote Network the Connector is attached to: %s.", utils.DocList(model.NetworkTypes)),
+			},
 			attr.StatusUpdatesEnabled: schema.BoolAttribute{
 				Computed:    true,
 				Description: "Determines whether status notifications are enabled for the Connector.",
@@ -123,6 +129,7 @@ func (d *connector) Read(ctx context.Context, req datasource.ReadRequest, resp *
 
 	data.Name = types.StringValue(connector.Name)
 	data.RemoteNetworkID = types.StringValue(connector.NetworkID)
+	data.RemoteNetworkType = types.StringValue(connector.NetworkType)
 	data.StatusUpdatesEnabled = types.BoolPointerValue(connector.StatusUpdatesEnabled)
 	data.State = types.StringValue(connector.State)
 	data.Version = types.StringValue(connector.Version)
@@ -7,9 +7,18 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,14 +36,21 @@ type connectors struct {
 }
 
 type connectorsModel struct {
-	ID           types.String     `tfsdk:"id"`
-	Name         types.String     `tfsdk:"name"`
-	NameRegexp   types.String     `tfsdk:"name_regexp"`
-	NameContains types.String     `tfsdk:"name_contains"`
-	NameExclude  types.String     `tfsdk:"name_exclude"`
-	NamePrefix   types.String     `tfsdk:"name_prefix"`
-	NameSuffix   types.String     `tfsdk:"name_suffix"`
-	Connectors   []connectorModel `tfsdk:"connectors"`
+	ID                types.String     `tfsdk:"id"`
+	Name              types.String     `tfsdk:"name"`
+	NameRegexp        types.String     `tfsdk:"name_regexp"`
+	NameContains      types.String     `tfsdk:"name_contains"`
+	NameExclude       types.String     `tfsdk:"name_exclude"`
+	NamePrefix        types.String     `tfsdk:"name_prefix"`
+	NameSuffix        types.String     `tfsdk:"name_suffix"`
+	RemoteNetworkType types.String     `tfsdk:"remote_network_type"`
+	RemoteNetworkID   types.String     `tfsdk:"remote_network_id"`
+	RemoteNetworkName types.String     `tfsdk:"remote_network_name"`
+	States            types.Set        `tfsdk:"states"`
+	VersionConstraint types.String     `tfsdk:"version_constraint"`
+	Hostname          types.String     `tfsdk:"hostname"`
+	IPAddress         types.String     `tfsdk:"ip_address"`
+	Connectors        []connectorModel `tfsdk:"connectors"`
 }
 
 func (d *connectors) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -93,6 +109,50 @@ func (d *connectors) Schema(ctx context.Context, req datasource.SchemaRequest, r
 				Optional:    true,
 				Description: "The name of the connector must end with the value.",
 			},
+			attr.RemoteNetworkType: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only connectors attached to Remote Networks of this type: %s.", utils.DocList(model.NetworkTypes)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.NetworkTypes...),
+				},
+			},
+			attr.RemoteNetworkID: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only connectors attached to the Remote Network with this ID.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot(attr.RemoteNetworkName)),
+				},
+			},
+			attr.RemoteNetworkName: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only connectors attached to the Remote Network with this name.",
+			},
+			attr.States: schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Returns only connectors in one of these states: %s.", utils.DocList(model.ConnectorStates)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(model.ConnectorStates...)),
+				},
+			},
+			attr.VersionConstraint: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only connectors whose version satisfies this constraint, e.g. `< 1.70.0` or `>= 1.60.0, < 1.70.0`. Connectors without a valid version never match.",
+				Validators: []validator.String{
+					customvalidator.VersionConstraint(),
+				},
+			},
+			attr.Hostname: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only connectors running on the machine with this hostname (case-insensitive).",
+			},
+			attr.IPAddress: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only connectors whose public or private IP address matches this IP or falls in this CIDR.",
+				Validators: []validator.String{
+					customvalidator.IPOrCIDR(),
+				},
+			},
 
 			// computed
 			attr.Connectors: schema.ListNestedAttribute{
@@ -112,6 +172,10 @@ func (d *connectors) Schema(ctx context.Context, req datasource.SchemaRequest, r
 							Computed:    true,
 							Description: "The ID of the Remote Network attached to the Connector.",
 						},
+						attr.RemoteNetworkType: schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("The type of the Remote Network attached to the Connector: %s.", utils.DocList(model.NetworkTypes)),
+						},
 						attr.StatusUpdatesEnabled: schema.BoolAttribute{
 							Computed:    true,
 							Description: "Determines whether status notifications are enabled for the Connector.",
@@ -162,6 +226,14 @@ func (d *connectors) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
+	connectorsFilter, err := d.buildConnectorsFilter(ctx, &data)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateConnectors)
+
+		return
+	}
+
+	// only the name filter is sent to the API, the rest are applied to its result
 	connectors, err := d.client.ReadConnectors(ctx, name, filter)
 	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
 		addErr(&resp.Diagnostics, err, TwingateConnectors)
@@ -169,9 +241,51 @@ func (d *connectors) Read(ctx context.Context, req datasource.ReadRequest, resp
 		return
 	}
 
+	connectors = utils.Filter(connectors, connectorsFilter.Match)
+
 	data.ID = types.StringValue("all-connectors")
 	data.Connectors = convertConnectorsToTerraform(connectors)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *connectors) buildConnectorsFilter(ctx context.Context, data *connectorsModel) (*model.ConnectorsFilter, error) {
+	filter := &model.ConnectorsFilter{
+		States: utils.Map(data.States.Elements(), func(item tfattr.Value) string {
+			return item.(types.String).ValueString()
+		}),
+		RemoteNetworkID:   data.RemoteNetworkID.ValueString(),
+		RemoteNetworkType: data.RemoteNetworkType.ValueString(),
+		Hostname:          data.Hostname.ValueString(),
+	}
+
+	if constraint := data.VersionConstraint.ValueString(); constraint != "" {
+		constraints, err := version.NewConstraint(constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", attr.VersionConstraint, err)
+		}
+
+		filter.Version = constraints
+	}
+
+	if ip := data.IPAddress.ValueString(); ip != "" {
+		address, err := utils.ParseAddress(ip)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", attr.IPAddress, err)
+		}
+
+		filter.IPAddress = address
+	}
+
+	if networkName := data.RemoteNetworkName.ValueString(); networkName != "" {
+		network, err := d.client.ReadRemoteNetworkByName(ctx, networkName)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		filter.RemoteNetworkID = network.ID
+	}
+
+	return filter, nil
+}
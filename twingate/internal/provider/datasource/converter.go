@@ -1,6 +1,9 @@
 package datasource
 
 import (
+	"sort"
+	"time"
+
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -12,6 +15,7 @@ func convertConnectorsToTerraform(connectors []*model.Connector) []connectorMode
 			ID:                   types.StringValue(connector.ID),
 			Name:                 types.StringValue(connector.Name),
 			RemoteNetworkID:      types.StringValue(connector.NetworkID),
+			RemoteNetworkType:    types.StringValue(connector.NetworkType),
 			StatusUpdatesEnabled: types.BoolPointerValue(connector.StatusUpdatesEnabled),
 			State:                types.StringValue(connector.State),
 			Version:              types.StringValue(connector.Version),
@@ -48,15 +52,16 @@ func convertResourcesToTerraform(resources []*model.Resource) []resourceModel {
 	})
 }
 
-func convertUsersToTerraform(users []*model.User) []userModel {
-	return utils.Map(users, func(user *model.User) userModel {
-		return userModel{
+func convertUsersToTerraform(users []*model.User) []userItemModel {
+	return utils.Map(users, func(user *model.User) userItemModel {
+		return userItemModel{
 			ID:        types.StringValue(user.ID),
 			FirstName: types.StringValue(user.FirstName),
 			LastName:  types.StringValue(user.LastName),
 			Email:     types.StringValue(user.Email),
 			Role:      types.StringValue(user.Role),
 			Type:      types.StringValue(user.Type),
+			GroupIDs:  utils.MakeStringSet(user.Groups),
 		}
 	})
 }
@@ -72,13 +77,61 @@ func convertServicesToTerraform(accounts []*model.ServiceAccount) []serviceAccou
 	})
 }
 
-func convertSecurityPoliciesToTerraform(policies []*model.SecurityPolicy) []securityPolicyModel {
-	return utils.Map(policies, func(policy *model.SecurityPolicy) securityPolicyModel {
-		return securityPolicyModel{
-			ID:   types.StringValue(policy.ID),
-			Name: types.StringValue(policy.Name),
+func convertServiceKeysToTerraform(keys []*model.ServiceKey, now time.Time) []serviceAccountKeyModel {
+	return utils.Map(keys, func(key *model.ServiceKey) serviceAccountKeyModel {
+		serviceKey := serviceAccountKeyModel{
+			ID:              types.StringValue(key.ID),
+			Name:            types.StringValue(key.Name),
+			Status:          types.StringValue(key.Status),
+			CreatedAt:       types.StringNull(),
+			ExpiresAt:       types.StringNull(),
+			DaysUntilExpiry: types.Int64Null(),
+		}
+
+		if !key.CreatedAt.IsZero() {
+			serviceKey.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+		}
+
+		if key.ExpiresAt != nil {
+			serviceKey.ExpiresAt = types.StringValue(key.ExpiresAt.Format(time.RFC3339))
+			serviceKey.DaysUntilExpiry = types.Int64Value(int64(*key.DaysUntilExpiry(now)))
+		}
+
+		return serviceKey
+	})
+}
+
+func convertSecurityPoliciesToTerraform(policies []*model.SecurityPolicy, resources []*model.Resource, includeUsage bool) []securityPolicyItemModel {
+	return utils.Map(policies, func(policy *model.SecurityPolicy) securityPolicyItemModel {
+		return convertSecurityPolicyToTerraform(policy, resources, includeUsage)
+	})
+}
+
+// convertSecurityPolicyToTerraform sets the usage of the policy in the resources only if it was requested.
+func convertSecurityPolicyToTerraform(policy *model.SecurityPolicy, resources []*model.Resource, includeUsage bool) securityPolicyItemModel {
+	item := securityPolicyItemModel{
+		ID:          types.StringValue(policy.ID),
+		Name:        types.StringValue(policy.Name),
+		PolicyType:  types.StringValue(policy.PolicyType),
+		Groups:      utils.MakeStringSet(policy.Groups),
+		ResourceIDs: types.SetNull(types.StringType),
+	}
+
+	if !includeUsage {
+		return item
+	}
+
+	usage := model.NewSecurityPolicyUsage(policy.ID, resources)
+
+	item.ResourceIDs = utils.MakeStringSet(usage.ResourceIDs)
+	item.AccessGroups = utils.Map(usage.AccessGroups, func(access model.SecurityPolicyAccessGroup) securityPolicyAccessGroupModel {
+		return securityPolicyAccessGroupModel{
+			ResourceID: types.StringValue(access.ResourceID),
+			GroupID:    types.StringValue(access.GroupID),
 		}
 	})
+
+	return item
 }
 
 func convertRemoteNetworksToTerraform(networks []*model.RemoteNetwork) []remoteNetworkModel {
@@ -97,3 +150,44 @@ func convertDomainsToTerraform(domains []string) *domainsModel {
 		Domains: utils.MakeStringSet(domains),
 	}
 }
+
+func convertResourceConflictsToTerraform(conflicts []model.ResourceConflict) []resourceConflictModel {
+	return utils.Map(conflicts, func(conflict model.ResourceConflict) resourceConflictModel {
+		return resourceConflictModel{
+			Type:                       types.StringValue(conflict.Type),
+			ResourceID:                 types.StringValue(conflict.ResourceID),
+			ResourceAddress:            types.StringValue(conflict.ResourceAddress),
+			ConflictingResourceID:      types.StringValue(conflict.ConflictingResourceID),
+			ConflictingResourceAddress: types.StringValue(conflict.ConflictingResourceAddress),
+			Description:                types.StringValue(conflict.Description),
+		}
+	})
+}
+
+func convertDNSFilteringProfilesToTerraform(profiles []*model.DNSFilteringProfile) []dnsFilteringProfileItemModel {
+	return utils.Map(profiles, func(profile *model.DNSFilteringProfile) dnsFilteringProfileItemModel {
+		return dnsFilteringProfileItemModel{
+			ID:             types.StringValue(profile.ID),
+			Name:           types.StringValue(profile.Name),
+			Priority:       types.Float64Value(profile.Priority),
+			FallbackMethod: types.StringValue(profile.FallbackMethod),
+			Groups:         utils.MakeStringSet(profile.Groups),
+		}
+	})
+}
+
+func convertOverlappingGroupsToTerraform(groups map[string][]string) []overlappingGroupModel {
+	groupIDs := make([]string, 0, len(groups))
+	for groupID := range groups {
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	sort.Strings(groupIDs)
+
+	return utils.Map(groupIDs, func(groupID string) overlappingGroupModel {
+		return overlappingGroupModel{
+			GroupID:    types.StringValue(groupID),
+			ProfileIDs: utils.MakeStringSet(groups[groupID]),
+		}
+	})
+}
@@ -3,6 +3,7 @@ package datasource
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -27,13 +28,14 @@ func TestConverterConnectorsToTerraform(t *testing.T) {
 		},
 		{
 			input: []*model.Connector{
-				{ID: "connector-id", Name: "connector-name", NetworkID: "network-id", StatusUpdatesEnabled: &boolTrue, State: "ALIVE"},
+				{ID: "connector-id", Name: "connector-name", NetworkID: "network-id", NetworkType: model.NetworkTypeExit, StatusUpdatesEnabled: &boolTrue, State: "ALIVE"},
 			},
 			expected: []connectorModel{
 				{
 					ID:                   types.StringValue("connector-id"),
 					Name:                 types.StringValue("connector-name"),
 					RemoteNetworkID:      types.StringValue("network-id"),
+					RemoteNetworkType:    types.StringValue(model.NetworkTypeExit),
 					StatusUpdatesEnabled: types.BoolValue(true),
 					State:                types.StringValue("ALIVE"),
 					Hostname:             types.StringValue(""),
@@ -92,22 +94,22 @@ func TestConverterGroupsToTerraform(t *testing.T) {
 func TestConverterUsersToTerraform(t *testing.T) {
 	cases := []struct {
 		input    []*model.User
-		expected []userModel
+		expected []userItemModel
 	}{
 		{
 			input:    nil,
-			expected: []userModel{},
+			expected: []userItemModel{},
 		},
 		{
 			input:    []*model.User{},
-			expected: []userModel{},
+			expected: []userItemModel{},
 		},
 		{
 			input: []*model.User{
-				{ID: "user-id", FirstName: "Name", LastName: "Last", Email: "user@email.com", Role: "USER", Type: "SYNCED"},
-				{ID: "admin-id", FirstName: "Admin", LastName: "Last", Email: "admin@email.com", Role: model.UserRoleAdmin, Type: "MANUAL"},
+				{ID: "user-id", FirstName: "Name", LastName: "Last", Email: "user@email.com", Role: "USER", Type: "SYNCED", Groups: []string{"group-1", "group-2"}},
+				{ID: "admin-id", FirstName: "Admin", LastName: "Last", Email: "admin@email.com", Role: model.UserRoleAdmin, Type: "MANUAL", Groups: []string{}},
 			},
-			expected: []userModel{
+			expected: []userItemModel{
 				{
 					ID:        types.StringValue("user-id"),
 					FirstName: types.StringValue("Name"),
@@ -115,6 +117,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 					Email:     types.StringValue("user@email.com"),
 					Role:      types.StringValue("USER"),
 					Type:      types.StringValue("SYNCED"),
+					GroupIDs:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("group-1"), types.StringValue("group-2")}),
 				},
 				{
 					ID:        types.StringValue("admin-id"),
@@ -123,6 +126,7 @@ func TestConverterUsersToTerraform(t *testing.T) {
 					Email:     types.StringValue("admin@email.com"),
 					Role:      types.StringValue(model.UserRoleAdmin),
 					Type:      types.StringValue("MANUAL"),
+					GroupIDs:  types.SetValueMust(types.StringType, []attr.Value{}),
 				},
 			},
 		},
@@ -268,14 +272,103 @@ func TestConvertServicesToTerraform(t *testing.T) {
 	}
 }
 
+func TestConvertServiceKeysToTerraform(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-48 * time.Hour)
+	expiresAt := now.Add(10*24*time.Hour + time.Hour)
+	expiredAt := now.Add(-36 * time.Hour)
+
+	cases := []struct {
+		input    []*model.ServiceKey
+		expected []serviceAccountKeyModel
+	}{
+		{
+			input:    nil,
+			expected: []serviceAccountKeyModel{},
+		},
+		{
+			input: []*model.ServiceKey{
+				{ID: "key-1", Name: "key-name-1", Status: model.StatusActive, CreatedAt: createdAt, ExpiresAt: &expiresAt},
+				{ID: "key-2", Name: "key-name-2", Status: model.StatusRevoked, CreatedAt: createdAt},
+				{ID: "key-3", Name: "key-name-3", Status: model.StatusExpired, ExpiresAt: &expiredAt},
+			},
+			expected: []serviceAccountKeyModel{
+				{
+					ID:              types.StringValue("key-1"),
+					Name:            types.StringValue("key-name-1"),
+					Status:          types.StringValue(model.StatusActive),
+					CreatedAt:       types.StringValue("2023-12-30T12:00:00Z"),
+					ExpiresAt:       types.StringValue("2024-01-11T13:00:00Z"),
+					DaysUntilExpiry: types.Int64Value(10),
+				},
+				{
+					ID:              types.StringValue("key-2"),
+					Name:            types.StringValue("key-name-2"),
+					Status:          types.StringValue(model.StatusRevoked),
+					CreatedAt:       types.StringValue("2023-12-30T12:00:00Z"),
+					ExpiresAt:       types.StringNull(),
+					DaysUntilExpiry: types.Int64Null(),
+				},
+				{
+					ID:              types.StringValue("key-3"),
+					Name:            types.StringValue("key-name-3"),
+					Status:          types.StringValue(model.StatusExpired),
+					CreatedAt:       types.StringNull(),
+					ExpiresAt:       types.StringValue("2023-12-31T00:00:00Z"),
+					DaysUntilExpiry: types.Int64Value(-1),
+				},
+			},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual := convertServiceKeysToTerraform(c.input, now)
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
 func TestConvertSecurityPoliciesToTerraform(t *testing.T) {
+	policyID := "policy-id"
+
+	resources := []*model.Resource{
+		{
+			ID:               "resource-id",
+			SecurityPolicyID: &policyID,
+			GroupsAccess: []model.AccessGroup{
+				{GroupID: "group-id", SecurityPolicyID: &policyID},
+			},
+		},
+	}
+
 	cases := []struct {
-		input    []*model.SecurityPolicy
-		expected []securityPolicyModel
+		input        []*model.SecurityPolicy
+		includeUsage bool
+		expected     []securityPolicyItemModel
 	}{
 		{
 			input:    nil,
-			expected: []securityPolicyModel{},
+			expected: []securityPolicyItemModel{},
+		},
+		{
+			input: []*model.SecurityPolicy{
+				{
+					ID:         "policy-id",
+					Name:       "policy-name",
+					PolicyType: "RESOURCE",
+					Groups:     []string{"group-id"},
+				},
+			},
+			expected: []securityPolicyItemModel{
+				{
+					ID:          types.StringValue("policy-id"),
+					Name:        types.StringValue("policy-name"),
+					PolicyType:  types.StringValue("RESOURCE"),
+					Groups:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("group-id")}),
+					ResourceIDs: types.SetNull(types.StringType),
+				},
+			},
 		},
 		{
 			input: []*model.SecurityPolicy{
@@ -284,10 +377,20 @@ func TestConvertSecurityPoliciesToTerraform(t *testing.T) {
 					Name: "policy-name",
 				},
 			},
-			expected: []securityPolicyModel{
+			includeUsage: true,
+			expected: []securityPolicyItemModel{
 				{
-					ID:   types.StringValue("policy-id"),
-					Name: types.StringValue("policy-name"),
+					ID:          types.StringValue("policy-id"),
+					Name:        types.StringValue("policy-name"),
+					PolicyType:  types.StringValue(""),
+					Groups:      types.SetValueMust(types.StringType, []attr.Value{}),
+					ResourceIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("resource-id")}),
+					AccessGroups: []securityPolicyAccessGroupModel{
+						{
+							ResourceID: types.StringValue("resource-id"),
+							GroupID:    types.StringValue("group-id"),
+						},
+					},
 				},
 			},
 		},
@@ -295,7 +398,7 @@ func TestConvertSecurityPoliciesToTerraform(t *testing.T) {
 
 	for n, c := range cases {
 		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
-			actual := convertSecurityPoliciesToTerraform(c.input)
+			actual := convertSecurityPoliciesToTerraform(c.input, resources, c.includeUsage)
 			assert.Equal(t, c.expected, actual)
 		})
 	}
@@ -370,3 +473,44 @@ func TestConvertDomainsToTerraform(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertOverlappingGroupsToTerraform(t *testing.T) {
+	cases := []struct {
+		input    map[string][]string
+		expected []overlappingGroupModel
+	}{
+		{
+			input:    nil,
+			expected: []overlappingGroupModel{},
+		},
+		{
+			input: map[string][]string{
+				"group-2": {"profile-1", "profile-2"},
+				"group-1": {"profile-2", "profile-3"},
+			},
+			expected: []overlappingGroupModel{
+				{
+					GroupID: types.StringValue("group-1"),
+					ProfileIDs: types.SetValueMust(types.StringType, []attr.Value{
+						types.StringValue("profile-2"),
+						types.StringValue("profile-3"),
+					}),
+				},
+				{
+					GroupID: types.StringValue("group-2"),
+					ProfileIDs: types.SetValueMust(types.StringType, []attr.Value{
+						types.StringValue("profile-1"),
+						types.StringValue("profile-2"),
+					}),
+				},
+			},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual := convertOverlappingGroupsToTerraform(c.input)
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
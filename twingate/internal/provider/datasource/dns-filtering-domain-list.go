@@ -0,0 +1,155 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxReportedInvalidDomains limits the invalid domains listed in the strict mode error.
+const maxReportedInvalidDomains = 10
+
+var ErrInvalidDomainsInList = errors.New("the domain list contains invalid domains")
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &dnsFilteringDomainList{}
+
+func NewDNSFilteringDomainListDatasource() datasource.DataSource {
+	return &dnsFilteringDomainList{}
+}
+
+type dnsFilteringDomainList struct{}
+
+type dnsFilteringDomainListModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Path           types.String   `tfsdk:"path"`
+	Content        types.String   `tfsdk:"content"`
+	Format         types.String   `tfsdk:"format"`
+	Strict         types.Bool     `tfsdk:"strict"`
+	Domains        types.Set      `tfsdk:"domains"`
+	DomainsCount   types.Int64    `tfsdk:"domains_count"`
+	Hash           types.String   `tfsdk:"hash"`
+	InvalidDomains []types.String `tfsdk:"invalid_domains"`
+	SkippedCount   types.Int64    `tfsdk:"skipped_count"`
+}
+
+func (d *dnsFilteringDomainList) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateDNSFilteringDomainList
+}
+
+func (d *dnsFilteringDomainList) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Parses a domain list in hosts file, Adblock or plain format into a normalized and de-duplicated set of domains that can be used as `allowed_domains` or `denied_domains` of a `twingate_dns_filtering_profile`. Only `||domain^` Adblock rules are supported, other rules are skipped.",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.FilePath: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The path of a local file with the domain list. Exactly one of `%s` or `%s` must be set.", attr.FilePath, attr.Content),
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(attr.Content)),
+				},
+			},
+			attr.Content: schema.StringAttribute{
+				Optional:    true,
+				Description: "The domain list, e.g. read with the `file` function or fetched by the `http` data source.",
+			},
+			attr.Format: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The format of the domain list: %s. The `%s` format detects the format of each line. Defaults to `%s`.", utils.DocList(utils.DomainListFormats), utils.DomainListFormatAuto, utils.DomainListFormatAuto),
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.DomainListFormats...),
+				},
+			},
+			attr.Strict: schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("If true, reading fails when the list contains invalid domains. Otherwise they are reported in `%s`. Defaults to false.", attr.InvalidDomains),
+			},
+			// computed
+			attr.Domains: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The normalized domains: lower cased, without trailing dots and leading `*.` wildcards.",
+			},
+			attr.DomainsCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of domains.",
+			},
+			attr.Hash: schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 checksum of the domains. Changes only when the set of domains changes, not when the file is reordered or reformatted.",
+			},
+			attr.InvalidDomains: schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The entries that failed domain validation.",
+			},
+			attr.SkippedCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of rules that can't be expressed as a domain, e.g. Adblock exceptions or URL patterns.",
+			},
+		},
+	}
+}
+
+func (d *dnsFilteringDomainList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dnsFilteringDomainListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := data.Content.ValueString()
+
+	if !data.Path.IsNull() {
+		raw, err := os.ReadFile(data.Path.ValueString())
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateDNSFilteringDomainList)
+
+			return
+		}
+
+		content = string(raw)
+	}
+
+	list, err := utils.ParseDomainList(content, data.Format.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, TwingateDNSFilteringDomainList)
+
+		return
+	}
+
+	if data.Strict.ValueBool() && len(list.Invalid) > 0 {
+		invalid := list.Invalid[:min(len(list.Invalid), maxReportedInvalidDomains)]
+
+		addErr(&resp.Diagnostics, fmt.Errorf("%w (%d): %s", ErrInvalidDomainsInList, len(list.Invalid), strings.Join(invalid, ", ")), TwingateDNSFilteringDomainList)
+
+		return
+	}
+
+	data.ID = types.StringValue("dns-filtering-domain-list-" + list.Hash())
+	data.Domains = utils.MakeStringSet(list.Domains)
+	data.DomainsCount = types.Int64Value(int64(len(list.Domains)))
+	data.Hash = types.StringValue(list.Hash())
+	data.InvalidDomains = utils.Map(list.Invalid, types.StringValue)
+	data.SkippedCount = types.Int64Value(int64(list.Skipped))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,260 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var ErrDNSFilteringProfilesDatasourceShouldSetOneOptionalNameAttribute = errors.New("Only one of name, name_regexp, name_contains, name_exclude, name_prefix or name_suffix must be set.")
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &dnsFilteringProfiles{}
+
+func NewDNSFilteringProfilesDatasource() datasource.DataSource {
+	return &dnsFilteringProfiles{}
+}
+
+type dnsFilteringProfiles struct {
+	client *client.Client
+}
+
+type dnsFilteringProfilesModel struct {
+	ID                   types.String                   `tfsdk:"id"`
+	Name                 types.String                   `tfsdk:"name"`
+	NameRegexp           types.String                   `tfsdk:"name_regexp"`
+	NameContains         types.String                   `tfsdk:"name_contains"`
+	NameExclude          types.String                   `tfsdk:"name_exclude"`
+	NamePrefix           types.String                   `tfsdk:"name_prefix"`
+	NameSuffix           types.String                   `tfsdk:"name_suffix"`
+	MinPriority          types.Float64                  `tfsdk:"min_priority"`
+	MaxPriority          types.Float64                  `tfsdk:"max_priority"`
+	FallbackMethod       types.String                   `tfsdk:"fallback_method"`
+	DNSFilteringProfiles []dnsFilteringProfileItemModel `tfsdk:"dns_filtering_profiles"`
+	OverlappingGroups    []overlappingGroupModel        `tfsdk:"overlapping_groups"`
+	UncoveredGroups      types.Set                      `tfsdk:"uncovered_groups"`
+}
+
+type dnsFilteringProfileItemModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	Priority       types.Float64 `tfsdk:"priority"`
+	FallbackMethod types.String  `tfsdk:"fallback_method"`
+	Groups         types.Set     `tfsdk:"groups"`
+}
+
+type overlappingGroupModel struct {
+	GroupID    types.String `tfsdk:"group_id"`
+	ProfileIDs types.Set    `tfsdk:"profile_ids"`
+}
+
+func (d *dnsFilteringProfiles) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateDNSFilteringProfiles
+}
+
+func (d *dnsFilteringProfiles) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+//nolint:funlen
+func (d *dnsFilteringProfiles) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists DNS filtering profiles with their groups, and reports the groups assigned to more than one profile and the active groups assigned to none. The group coverage is computed across the profiles that match the filters. For more information, see Twingate's [documentation](https://www.twingate.com/docs/dns-filtering).",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.Name: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only DNS filtering profiles that exactly match this name. If no options are passed it will return all DNS filtering profiles. Only one option can be used at a time.",
+			},
+			attr.Name + attr.FilterByRegexp: schema.StringAttribute{
+				Optional:    true,
+				Description: "The regular expression match of the name of the DNS filtering profile.",
+			},
+			attr.Name + attr.FilterByContains: schema.StringAttribute{
+				Optional:    true,
+				Description: "Match when the value exist in the name of the DNS filtering profile.",
+			},
+			attr.Name + attr.FilterByExclude: schema.StringAttribute{
+				Optional:    true,
+				Description: "Match when the exact value does not exist in the name of the DNS filtering profile.",
+			},
+			attr.Name + attr.FilterByPrefix: schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the DNS filtering profile must start with the value.",
+			},
+			attr.Name + attr.FilterBySuffix: schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the DNS filtering profile must end with the value.",
+			},
+			attr.MinPriority: schema.Float64Attribute{
+				Optional:    true,
+				Description: "Returns only DNS filtering profiles with a priority greater than or equal to the value.",
+			},
+			attr.MaxPriority: schema.Float64Attribute{
+				Optional:    true,
+				Description: "Returns only DNS filtering profiles with a priority less than or equal to the value.",
+			},
+			attr.FallbackMethod: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only DNS filtering profiles with this fallback method: %s.", utils.DocList(model.FallbackMethods)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.FallbackMethods...),
+				},
+			},
+			// computed
+			attr.DNSFilteringProfiles: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of DNS filtering profiles",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the DNS filtering profile.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the DNS filtering profile.",
+						},
+						attr.Priority: schema.Float64Attribute{
+							Computed:    true,
+							Description: "A floating point number representing the profile's priority.",
+						},
+						attr.FallbackMethod: schema.StringAttribute{
+							Computed:    true,
+							Description: "The DNS filtering profile's fallback method.",
+						},
+						attr.Groups: schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "A set of group IDs that have this as their DNS filtering profile.",
+						},
+					},
+				},
+			},
+			attr.OverlappingGroups: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The groups assigned to more than one DNS filtering profile.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.GroupID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the group.",
+						},
+						attr.ProfileIDs: schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the DNS filtering profiles the group is assigned to.",
+						},
+					},
+				},
+			},
+			attr.UncoveredGroups: schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the active groups not assigned to any DNS filtering profile.",
+			},
+		},
+	}
+}
+
+func (d *dnsFilteringProfiles) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dnsFilteringProfilesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if CountOptionalAttributes(data.Name, data.NameRegexp, data.NameContains, data.NameExclude, data.NamePrefix, data.NameSuffix) > 1 {
+		addErr(&resp.Diagnostics, ErrDNSFilteringProfilesDatasourceShouldSetOneOptionalNameAttribute, TwingateDNSFilteringProfiles)
+
+		return
+	}
+
+	ctx = client.WithCallerCtx(ctx, datasourceKey)
+
+	shallowProfiles, err := d.client.ReadShallowDNSFilteringProfiles(ctx)
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		addErr(&resp.Diagnostics, err, TwingateDNSFilteringProfiles)
+
+		return
+	}
+
+	name, nameFilter := GetNameFilter(data.Name, data.NameRegexp, data.NameContains, data.NameExclude, data.NamePrefix, data.NameSuffix)
+	filter := &model.DNSFilteringProfilesFilter{
+		Name:           name,
+		NameFilter:     nameFilter,
+		MinPriority:    data.MinPriority.ValueFloat64Pointer(),
+		MaxPriority:    data.MaxPriority.ValueFloat64Pointer(),
+		FallbackMethod: data.FallbackMethod.ValueString(),
+	}
+
+	// the list of profiles doesn't include the groups and the fallback method,
+	// so only the profiles matching the other filters are read
+	shallowProfiles = utils.Filter(shallowProfiles, filter.MatchShallow)
+	profiles := make([]*model.DNSFilteringProfile, 0, len(shallowProfiles))
+
+	for _, shallowProfile := range shallowProfiles {
+		profile, err := d.client.ReadDNSFilteringProfile(ctx, shallowProfile.ID)
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateDNSFilteringProfiles)
+
+			return
+		}
+
+		profiles = append(profiles, profile)
+	}
+
+	profiles = utils.Filter(profiles, filter.Match)
+
+	isActive := true
+
+	activeGroups, err := d.client.ReadGroups(ctx, &model.GroupsFilter{IsActive: &isActive})
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		addErr(&resp.Diagnostics, err, TwingateDNSFilteringProfiles)
+
+		return
+	}
+
+	coverage := model.NewDNSFilteringCoverage(profiles, utils.Map(activeGroups, func(group *model.Group) string {
+		return group.ID
+	}))
+
+	data.ID = types.StringValue("all-dns-filtering-profiles")
+	data.DNSFilteringProfiles = convertDNSFilteringProfilesToTerraform(profiles)
+	data.OverlappingGroups = convertOverlappingGroupsToTerraform(coverage.OverlappingGroups)
+	data.UncoveredGroups = utils.MakeStringSet(coverage.UncoveredGroups)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
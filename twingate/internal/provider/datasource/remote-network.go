@@ -85,7 +85,7 @@ func (d *remoteNetwork) Schema(ctx context.Context, req datasource.SchemaRequest
 			},
 			attr.Type: schema.StringAttribute{
 				Computed:    true,
-				Description: fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s.", strings.Join([]string{model.NetworkTypeRegular, model.NetworkTypeExit}, ", ")),
+				Description: fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s.", strings.Join(model.NetworkTypes, ", ")),
 			},
 		},
 	}
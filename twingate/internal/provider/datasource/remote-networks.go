@@ -10,8 +10,11 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -36,6 +39,7 @@ type remoteNetworksModel struct {
 	NameExclude    types.String         `tfsdk:"name_exclude"`
 	NamePrefix     types.String         `tfsdk:"name_prefix"`
 	NameSuffix     types.String         `tfsdk:"name_suffix"`
+	Type           types.String         `tfsdk:"type"`
 	RemoteNetworks []remoteNetworkModel `tfsdk:"remote_networks"`
 }
 
@@ -94,6 +98,13 @@ func (d *remoteNetworks) Schema(ctx context.Context, req datasource.SchemaReques
 				Optional:    true,
 				Description: "The name of the remote network must end with the value.",
 			},
+			attr.Type: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only remote networks of this type: %s.", utils.DocList(model.NetworkTypes)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.NetworkTypes...),
+				},
+			},
 
 			attr.RemoteNetworks: schema.ListNestedAttribute{
 				Computed:    true,
@@ -114,7 +125,7 @@ func (d *remoteNetworks) Schema(ctx context.Context, req datasource.SchemaReques
 						},
 						attr.Type: schema.StringAttribute{
 							Computed:    true,
-							Description: fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s.", strings.Join([]string{model.NetworkTypeRegular, model.NetworkTypeExit}, ", ")),
+							Description: fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s.", strings.Join(model.NetworkTypes, ", ")),
 						},
 					},
 				},
@@ -148,6 +159,12 @@ func (d *remoteNetworks) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	if networkType := data.Type.ValueString(); networkType != "" {
+		networks = utils.Filter(networks, func(network *model.RemoteNetwork) bool {
+			return network.Type == networkType
+		})
+	}
+
 	data.ID = types.StringValue("all-remote-networks")
 	data.RemoteNetworks = convertRemoteNetworksToTerraform(networks)
 
@@ -0,0 +1,167 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &resourceConflicts{}
+
+func NewResourceConflictsDatasource() datasource.DataSource {
+	return &resourceConflicts{}
+}
+
+type resourceConflicts struct {
+	client *client.Client
+}
+
+type resourceConflictsModel struct {
+	ID              types.String            `tfsdk:"id"`
+	RemoteNetworkID types.String            `tfsdk:"remote_network_id"`
+	ConflictTypes   types.Set               `tfsdk:"conflict_types"`
+	Conflicts       []resourceConflictModel `tfsdk:"conflicts"`
+}
+
+type resourceConflictModel struct {
+	Type                       types.String `tfsdk:"type"`
+	ResourceID                 types.String `tfsdk:"resource_id"`
+	ResourceAddress            types.String `tfsdk:"resource_address"`
+	ConflictingResourceID      types.String `tfsdk:"conflicting_resource_id"`
+	ConflictingResourceAddress types.String `tfsdk:"conflicting_resource_address"`
+	Description                types.String `tfsdk:"description"`
+}
+
+func (d *resourceConflicts) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateResourceConflicts
+}
+
+func (d *resourceConflicts) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *resourceConflicts) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detects Resources in a Remote Network whose addresses are resolved against each other by Twingate: overlapping IP addresses, identical hostnames, wildcard entries shadowed by more specific ones and port ranges reachable through Resources with differing access groups. Combine it with a `check` block to fail a plan on conflicts.",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.RemoteNetworkID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Remote Network to check.",
+			},
+			attr.ConflictTypes: schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Returns only conflicts of the given types. Valid types: %s. If not set, all conflicts are returned.", utils.DocList(model.ConflictTypes)),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(model.ConflictTypes...)),
+				},
+			},
+			attr.Conflicts: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of conflicts found between the Resources of the Remote Network.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.Type: schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("The type of the conflict: %s.", utils.DocList(model.ConflictTypes)),
+						},
+						attr.ResourceID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Resource. For shadowed wildcards this is the wildcard Resource.",
+						},
+						attr.ResourceAddress: schema.StringAttribute{
+							Computed:    true,
+							Description: "The address of the Resource.",
+						},
+						attr.ConflictingResourceID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the conflicting Resource.",
+						},
+						attr.ConflictingResourceAddress: schema.StringAttribute{
+							Computed:    true,
+							Description: "The address of the conflicting Resource.",
+						},
+						attr.Description: schema.StringAttribute{
+							Computed:    true,
+							Description: "A human readable description of the conflict.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resourceConflicts) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data resourceConflictsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources, err := d.client.ReadFullResourcesByRemoteNetwork(client.WithCallerCtx(ctx, datasourceKey), data.RemoteNetworkID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		addErr(&resp.Diagnostics, err, TwingateResourceConflicts)
+
+		return
+	}
+
+	conflicts := model.FindResourceConflicts(resources)
+
+	if conflictTypes := convertConflictTypes(data.ConflictTypes); len(conflictTypes) > 0 {
+		conflicts = utils.Filter(conflicts, func(conflict model.ResourceConflict) bool {
+			return conflictTypes[conflict.Type]
+		})
+	}
+
+	data.ID = types.StringValue("resource-conflicts-" + data.RemoteNetworkID.ValueString())
+	data.Conflicts = convertResourceConflictsToTerraform(conflicts)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func convertConflictTypes(set types.Set) map[string]bool {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	return utils.MakeLookupMap(utils.Map(set.Elements(), func(item tfattr.Value) string {
+		return item.(types.String).ValueString()
+	}))
+}
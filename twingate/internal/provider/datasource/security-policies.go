@@ -7,6 +7,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,14 +28,24 @@ type securityPolicies struct {
 }
 
 type securityPoliciesModel struct {
-	ID               types.String          `tfsdk:"id"`
-	Name             types.String          `tfsdk:"name"`
-	NameRegexp       types.String          `tfsdk:"name_regexp"`
-	NameContains     types.String          `tfsdk:"name_contains"`
-	NameExclude      types.String          `tfsdk:"name_exclude"`
-	NamePrefix       types.String          `tfsdk:"name_prefix"`
-	NameSuffix       types.String          `tfsdk:"name_suffix"`
-	SecurityPolicies []securityPolicyModel `tfsdk:"security_policies"`
+	ID               types.String              `tfsdk:"id"`
+	Name             types.String              `tfsdk:"name"`
+	NameRegexp       types.String              `tfsdk:"name_regexp"`
+	NameContains     types.String              `tfsdk:"name_contains"`
+	NameExclude      types.String              `tfsdk:"name_exclude"`
+	NamePrefix       types.String              `tfsdk:"name_prefix"`
+	NameSuffix       types.String              `tfsdk:"name_suffix"`
+	IncludeUsage     types.Bool                `tfsdk:"include_usage"`
+	SecurityPolicies []securityPolicyItemModel `tfsdk:"security_policies"`
+}
+
+type securityPolicyItemModel struct {
+	ID           types.String                     `tfsdk:"id"`
+	Name         types.String                     `tfsdk:"name"`
+	PolicyType   types.String                     `tfsdk:"policy_type"`
+	Groups       types.Set                        `tfsdk:"groups"`
+	ResourceIDs  types.Set                        `tfsdk:"resource_ids"`
+	AccessGroups []securityPolicyAccessGroupModel `tfsdk:"access_groups"`
 }
 
 func (d *securityPolicies) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -91,6 +102,10 @@ func (d *securityPolicies) Schema(ctx context.Context, req datasource.SchemaRequ
 				Optional:    true,
 				Description: "The name of the security policy must end with the value.",
 			},
+			attr.IncludeUsage: schema.BoolAttribute{
+				Optional:    true,
+				Description: includeUsageDescription,
+			},
 			attr.SecurityPolicies: schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -103,6 +118,10 @@ func (d *securityPolicies) Schema(ctx context.Context, req datasource.SchemaRequ
 							Computed:    true,
 							Description: "Return a Security Policy that exactly matches this name.",
 						},
+						attr.PolicyType:   securityPolicyDetailsAttributes[attr.PolicyType],
+						attr.Groups:       securityPolicyDetailsAttributes[attr.Groups],
+						attr.ResourceIDs:  securityPolicyDetailsAttributes[attr.ResourceIDs],
+						attr.AccessGroups: securityPolicyDetailsAttributes[attr.AccessGroups],
 					},
 				},
 			},
@@ -135,8 +154,19 @@ func (d *securityPolicies) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	var resources []*model.Resource
+
+	if data.IncludeUsage.ValueBool() {
+		resources, err = d.client.ReadFullResources(ctx)
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateSecurityPolicies)
+
+			return
+		}
+	}
+
 	data.ID = types.StringValue("security-policies-all")
-	data.SecurityPolicies = convertSecurityPoliciesToTerraform(policies)
+	data.SecurityPolicies = convertSecurityPoliciesToTerraform(policies, resources, data.IncludeUsage.ValueBool())
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
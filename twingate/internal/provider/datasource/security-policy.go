@@ -6,6 +6,7 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -27,8 +28,18 @@ type securityPolicy struct {
 }
 
 type securityPolicyModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID           types.String                     `tfsdk:"id"`
+	Name         types.String                     `tfsdk:"name"`
+	PolicyType   types.String                     `tfsdk:"policy_type"`
+	Groups       types.Set                        `tfsdk:"groups"`
+	IncludeUsage types.Bool                       `tfsdk:"include_usage"`
+	ResourceIDs  types.Set                        `tfsdk:"resource_ids"`
+	AccessGroups []securityPolicyAccessGroupModel `tfsdk:"access_groups"`
+}
+
+type securityPolicyAccessGroupModel struct {
+	ResourceID types.String `tfsdk:"resource_id"`
+	GroupID    types.String `tfsdk:"group_id"`
 }
 
 func (d *securityPolicy) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -75,10 +86,56 @@ func (d *securityPolicy) Schema(ctx context.Context, req datasource.SchemaReques
 					}...),
 				},
 			},
+			attr.IncludeUsage: schema.BoolAttribute{
+				Optional:    true,
+				Description: includeUsageDescription,
+			},
+			attr.PolicyType:   securityPolicyDetailsAttributes[attr.PolicyType],
+			attr.Groups:       securityPolicyDetailsAttributes[attr.Groups],
+			attr.ResourceIDs:  securityPolicyDetailsAttributes[attr.ResourceIDs],
+			attr.AccessGroups: securityPolicyDetailsAttributes[attr.AccessGroups],
 		},
 	}
 }
 
+const includeUsageDescription = "If true, reads all Resources to find the ones referencing the Security Policy, and sets `resource_ids` and `access_groups`. Defaults to false."
+
+// securityPolicyDetailsAttributes are the computed attributes describing what a Security Policy applies to.
+//
+//nolint:gochecknoglobals
+var securityPolicyDetailsAttributes = map[string]schema.Attribute{
+	attr.PolicyType: schema.StringAttribute{
+		Computed:    true,
+		Description: "The type of the Security Policy.",
+	},
+	attr.Groups: schema.SetAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "The IDs of the groups the Security Policy is applied to.",
+	},
+	attr.ResourceIDs: schema.SetAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: "The IDs of the Resources that use the Security Policy as their Resource policy. Only set when `include_usage` is true.",
+	},
+	attr.AccessGroups: schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The group access entries of Resources that use the Security Policy. Only set when `include_usage` is true.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				attr.ResourceID: schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the Resource.",
+				},
+				attr.GroupID: schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID of the group.",
+				},
+			},
+		},
+	},
+}
+
 func (d *securityPolicy) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data securityPolicyModel
 
@@ -96,8 +153,25 @@ func (d *securityPolicy) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	data.ID = types.StringValue(policy.ID)
-	data.Name = types.StringValue(policy.Name)
+	var resources []*model.Resource
+
+	if data.IncludeUsage.ValueBool() {
+		resources, err = d.client.ReadFullResources(ctx)
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateSecurityPolicy)
+
+			return
+		}
+	}
+
+	item := convertSecurityPolicyToTerraform(policy, resources, data.IncludeUsage.ValueBool())
+
+	data.ID = item.ID
+	data.Name = item.Name
+	data.PolicyType = item.PolicyType
+	data.Groups = item.Groups
+	data.ResourceIDs = item.ResourceIDs
+	data.AccessGroups = item.AccessGroups
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
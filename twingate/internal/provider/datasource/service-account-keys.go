@@ -0,0 +1,177 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var _ datasource.DataSource = &serviceAccountKeys{}
+
+func NewServiceAccountKeysDatasource() datasource.DataSource {
+	return &serviceAccountKeys{}
+}
+
+type serviceAccountKeys struct {
+	client *client.Client
+}
+
+type serviceAccountKeysModel struct {
+	ID               types.String             `tfsdk:"id"`
+	ServiceAccountID types.String             `tfsdk:"service_account_id"`
+	Status           types.String             `tfsdk:"status"`
+	ExpiringWithin   types.String             `tfsdk:"expiring_within"`
+	Keys             []serviceAccountKeyModel `tfsdk:"keys"`
+}
+
+type serviceAccountKeyModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+	DaysUntilExpiry types.Int64  `tfsdk:"days_until_expiry"`
+}
+
+func (d *serviceAccountKeys) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = TwingateServiceAccountKeys
+}
+
+func (d *serviceAccountKeys) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *serviceAccountKeys) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Service Keys of a Service Account with their status and expiration, e.g. to drive key rotation and alerts.",
+		Attributes: map[string]schema.Attribute{
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: computedDatasourceIDDescription,
+			},
+			attr.ServiceAccountID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Service Account.",
+			},
+			attr.Status: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only Service Keys with the given status: %s.", utils.DocList(model.ServiceKeyStatuses)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.ServiceKeyStatuses...),
+				},
+			},
+			attr.ExpiringWithin: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only Service Keys that expire within the given duration from now, including already expired ones. Keys that never expire are excluded. Examples of valid values include `12h` and `30d`.",
+				Validators: []validator.String{
+					customvalidator.Duration(),
+				},
+			},
+			attr.Keys: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of Service Keys",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the Service Key",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Service Key",
+						},
+						attr.Status: schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Status of the Service Key: %s.", utils.DocList(model.ServiceKeyStatuses)),
+						},
+						attr.CreatedAt: schema.StringAttribute{
+							Computed:    true,
+							Description: "The creation time of the Service Key in RFC 3339 format.",
+						},
+						attr.ExpiresAt: schema.StringAttribute{
+							Computed:    true,
+							Description: "The expiration time of the Service Key in RFC 3339 format. Null if the key never expires.",
+						},
+						attr.DaysUntilExpiry: schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of whole days until the Service Key expires, negative if it has already expired. Null if the key never expires.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *serviceAccountKeys) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serviceAccountKeysModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := d.client.ReadServiceKeys(client.WithCallerCtx(ctx, datasourceKey), data.ServiceAccountID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		addErr(&resp.Diagnostics, err, TwingateServiceAccountKeys)
+
+		return
+	}
+
+	now := time.Now()
+
+	if status := data.Status.ValueString(); status != "" {
+		keys = utils.Filter(keys, func(key *model.ServiceKey) bool {
+			return key.Status == status
+		})
+	}
+
+	if !data.ExpiringWithin.IsNull() {
+		window, err := utils.ParseDurationWithDays(data.ExpiringWithin.ValueString())
+		if err != nil {
+			addErr(&resp.Diagnostics, err, TwingateServiceAccountKeys)
+
+			return
+		}
+
+		keys = utils.Filter(keys, func(key *model.ServiceKey) bool {
+			return key.ExpiresWithin(now, window)
+		})
+	}
+
+	data.ID = types.StringValue("service-account-keys-" + data.ServiceAccountID.ValueString())
+	data.Keys = convertServiceKeysToTerraform(keys, now)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
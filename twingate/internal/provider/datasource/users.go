@@ -10,11 +10,13 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -37,27 +39,41 @@ type users struct {
 }
 
 type usersModel struct {
-	ID                types.String `tfsdk:"id"`
-	Email             types.String `tfsdk:"email"`
-	EmailRegexp       types.String `tfsdk:"email_regexp"`
-	EmailContains     types.String `tfsdk:"email_contains"`
-	EmailExclude      types.String `tfsdk:"email_exclude"`
-	EmailPrefix       types.String `tfsdk:"email_prefix"`
-	EmailSuffix       types.String `tfsdk:"email_suffix"`
-	FirstName         types.String `tfsdk:"first_name"`
-	FirstNameRegexp   types.String `tfsdk:"first_name_regexp"`
-	FirstNameContains types.String `tfsdk:"first_name_contains"`
-	FirstNameExclude  types.String `tfsdk:"first_name_exclude"`
-	FirstNamePrefix   types.String `tfsdk:"first_name_prefix"`
-	FirstNameSuffix   types.String `tfsdk:"first_name_suffix"`
-	LastName          types.String `tfsdk:"last_name"`
-	LastNameRegexp    types.String `tfsdk:"last_name_regexp"`
-	LastNameContains  types.String `tfsdk:"last_name_contains"`
-	LastNameExclude   types.String `tfsdk:"last_name_exclude"`
-	LastNamePrefix    types.String `tfsdk:"last_name_prefix"`
-	LastNameSuffix    types.String `tfsdk:"last_name_suffix"`
-	Roles             types.Set    `tfsdk:"roles"`
-	Users             []userModel  `tfsdk:"users"`
+	ID                types.String    `tfsdk:"id"`
+	Email             types.String    `tfsdk:"email"`
+	EmailRegexp       types.String    `tfsdk:"email_regexp"`
+	EmailContains     types.String    `tfsdk:"email_contains"`
+	EmailExclude      types.String    `tfsdk:"email_exclude"`
+	EmailPrefix       types.String    `tfsdk:"email_prefix"`
+	EmailSuffix       types.String    `tfsdk:"email_suffix"`
+	FirstName         types.String    `tfsdk:"first_name"`
+	FirstNameRegexp   types.String    `tfsdk:"first_name_regexp"`
+	FirstNameContains types.String    `tfsdk:"first_name_contains"`
+	FirstNameExclude  types.String    `tfsdk:"first_name_exclude"`
+	FirstNamePrefix   types.String    `tfsdk:"first_name_prefix"`
+	FirstNameSuffix   types.String    `tfsdk:"first_name_suffix"`
+	LastName          types.String    `tfsdk:"last_name"`
+	LastNameRegexp    types.String    `tfsdk:"last_name_regexp"`
+	LastNameContains  types.String    `tfsdk:"last_name_contains"`
+	LastNameExclude   types.String    `tfsdk:"last_name_exclude"`
+	LastNamePrefix    types.String    `tfsdk:"last_name_prefix"`
+	LastNameSuffix    types.String    `tfsdk:"last_name_suffix"`
+	Roles             types.Set       `tfsdk:"roles"`
+	State             types.String    `tfsdk:"state"`
+	Type              types.String    `tfsdk:"type"`
+	MemberOfGroup     types.String    `tfsdk:"member_of_group"`
+	NotInAnyGroup     types.Bool      `tfsdk:"not_in_any_group"`
+	Users             []userItemModel `tfsdk:"users"`
+}
+
+type userItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	Type      types.String `tfsdk:"type"`
+	GroupIDs  types.Set    `tfsdk:"group_ids"`
 }
 
 func (d *users) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -182,6 +198,32 @@ func (d *users) Schema(ctx context.Context, req datasource.SchemaRequest, resp *
 				},
 			},
 
+			attr.State: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only users in this state: %s. Pending users are considered `%s`.", utils.DocList(model.UserStates), model.UserStateActive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.UserStates...),
+				},
+			},
+			attr.Type: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Returns only users of this type: %s.", utils.DocList(model.UserTypes)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(model.UserTypes...),
+				},
+			},
+			attr.MemberOfGroup: schema.StringAttribute{
+				Optional:    true,
+				Description: "Returns only users that are members of the group with this ID.",
+			},
+			attr.NotInAnyGroup: schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, returns only users that are not members of any group, system groups like Everyone aside.",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot(attr.MemberOfGroup)),
+				},
+			},
+
 			attr.Users: schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -210,6 +252,11 @@ func (d *users) Schema(ctx context.Context, req datasource.SchemaRequest, resp *
 							Computed:    true,
 							Description: fmt.Sprintf("Indicates the User's type. Either %s.", utils.DocList(model.UserTypes)),
 						},
+						attr.GroupIDs: schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the groups the User is a member of, system groups like Everyone aside.",
+						},
 					},
 				},
 			},
@@ -285,8 +332,25 @@ func (d *users) Read(ctx context.Context, req datasource.ReadRequest, resp *data
 		return
 	}
 
+	// group memberships are read from the groups, as users don't expose them
+	groups, err := d.client.ReadGroups(client.WithCallerCtx(ctx, datasourceKey), nil)
+	if err != nil && !errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		addErr(&resp.Diagnostics, err, TwingateUsers)
+
+		return
+	}
+
+	model.AssignUserGroups(users, groups)
+
+	usersFilter := &model.UsersFilter{
+		State:         data.State.ValueString(),
+		Type:          data.Type.ValueString(),
+		MemberOfGroup: data.MemberOfGroup.ValueString(),
+		NotInAnyGroup: data.NotInAnyGroup.ValueBool(),
+	}
+
 	data.ID = types.StringValue("users-all")
-	data.Users = convertUsersToTerraform(users)
+	data.Users = convertUsersToTerraform(utils.Filter(users, usersFilter.Match))
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -1,21 +1,24 @@
 package resource
 
 const (
-	TwingateRemoteNetwork            = "twingate_remote_network"
-	TwingateConnector                = "twingate_connector"
-	TwingateConnectorTokens          = "twingate_connector_tokens"
-	TwingateGroup                    = "twingate_group"
-	TwingateResource                 = "twingate_resource"
-	TwingateServiceAccount           = "twingate_service_account"
-	TwingateServiceAccountKey        = "twingate_service_account_key"
-	TwingateUser                     = "twingate_user"
-	TwingateDNSFilteringProfile      = "twingate_dns_filtering_profile"
-	TwingateX509CertificateAuthority = "twingate_x509_certificate_authority"
-	TwingateSSHCertificateAuthority  = "twingate_ssh_certificate_authority"
-	TwingateGateway                  = "twingate_gateway"
-	TwingateSSHResource              = "twingate_ssh_resource"
-	TwingateKubernetesResource       = "twingate_kubernetes_resource"
-	TwingateGatewayConfig            = "twingate_gateway_config"
+	TwingateRemoteNetwork             = "twingate_remote_network"
+	TwingateConnector                 = "twingate_connector"
+	TwingateConnectorTokens           = "twingate_connector_tokens"
+	TwingateGroup                     = "twingate_group"
+	TwingateResource                  = "twingate_resource"
+	TwingateServiceAccount            = "twingate_service_account"
+	TwingateServiceAccountKey         = "twingate_service_account_key"
+	TwingateUser                      = "twingate_user"
+	TwingateDNSFilteringProfile       = "twingate_dns_filtering_profile"
+	TwingateX509CertificateAuthority  = "twingate_x509_certificate_authority"
+	TwingateSSHCertificateAuthority   = "twingate_ssh_certificate_authority"
+	TwingateGateway                   = "twingate_gateway"
+	TwingateSSHResource               = "twingate_ssh_resource"
+	TwingateKubernetesResource        = "twingate_kubernetes_resource"
+	TwingateGatewayConfig             = "twingate_gateway_config"
+	TwingateServiceAccountKeyRotation = "twingate_service_account_key_rotation"
+	TwingateDNSFilteringProfileOrder  = "twingate_dns_filtering_profile_order"
+	TwingateUsersBulk                 = "twingate_users_bulk"
 
 	operationCreate = "create"
 	operationRead   = "read"
@@ -2,22 +2,33 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const privateConnectorTokens = "connector_tokens"
+
 // Ensure the implementation satisfies the desired interfaces.
-var _ resource.Resource = &connectorTokens{}
+var (
+	_ resource.Resource               = &connectorTokens{}
+	_ resource.ResourceWithModifyPlan = &connectorTokens{}
+)
 
 func NewConnectorTokensResource() resource.Resource {
 	return &connectorTokens{}
@@ -28,11 +39,56 @@ type connectorTokens struct {
 }
 
 type connectorTokensModel struct {
-	ID           types.String `tfsdk:"id"`
-	ConnectorID  types.String `tfsdk:"connector_id"`
-	AccessToken  types.String `tfsdk:"access_token"`
-	RefreshToken types.String `tfsdk:"refresh_token"`
-	Keepers      types.Map    `tfsdk:"keepers"`
+	ID               types.String `tfsdk:"id"`
+	ConnectorID      types.String `tfsdk:"connector_id"`
+	AccessToken      types.String `tfsdk:"access_token"`
+	RefreshToken     types.String `tfsdk:"refresh_token"`
+	Keepers          types.Map    `tfsdk:"keepers"`
+	RotationInterval types.String `tfsdk:"rotation_interval"`
+	RotateOnRevoked  types.Bool   `tfsdk:"rotate_on_revoked"`
+}
+
+// connectorTokensPrivateState is kept in the resource private state, so it's never shown to users.
+type connectorTokensPrivateState struct {
+	IssuedAt time.Time `json:"issued_at"`
+	Revoked  bool      `json:"revoked,omitempty"`
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+func getConnectorTokensPrivateState(ctx context.Context, private privateStateGetter) (*connectorTokensPrivateState, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, privateConnectorTokens)
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	var state connectorTokensPrivateState
+	if err := json.Unmarshal(data, &state); err != nil {
+		diags.AddError("failed to read private state of "+TwingateConnectorTokens, err.Error())
+
+		return nil, diags
+	}
+
+	return &state, diags
+}
+
+func setConnectorTokensPrivateState(ctx context.Context, private privateStateSetter, state *connectorTokensPrivateState) diag.Diagnostics {
+	data, err := json.Marshal(state)
+	if err != nil {
+		var diags diag.Diagnostics
+
+		diags.AddError("failed to save private state of "+TwingateConnectorTokens, err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, privateConnectorTokens, data)
 }
 
 func (r *connectorTokens) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,6 +128,17 @@ func (r *connectorTokens) Schema(_ context.Context, _ resource.SchemaRequest, re
 				ElementType: types.StringType,
 				Description: "Arbitrary map of values that, when changed, will trigger recreation of resource. Use this to automatically rotate Connector tokens on a schedule.",
 			},
+			attr.RotationInterval: schema.StringAttribute{
+				Optional:    true,
+				Description: "The age after which the tokens are regenerated: the first plan after the interval has elapsed replaces the resource. Duration must be between 1 hour and 365 days. Examples of valid values include `12h` and `30d`.",
+				Validators: []validator.String{
+					customvalidator.Duration(),
+				},
+			},
+			attr.RotateOnRevoked: schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, tokens that can no longer be verified, e.g. because they were revoked, are regenerated by replacing the resource. Otherwise the resource is removed from the state. Default is `false`.",
+			},
 			// computed
 			attr.AccessToken: schema.StringAttribute{
 				Computed:    true,
@@ -115,28 +182,132 @@ func (r *connectorTokens) Create(ctx context.Context, req resource.CreateRequest
 	plan.RefreshToken = types.StringValue(tokens.RefreshToken)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setConnectorTokensPrivateState(ctx, resp.Private, &connectorTokensPrivateState{IssuedAt: time.Now().UTC()})...)
+}
+
+func (r *connectorTokens) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state connectorTokensModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	privateState, diags := getConnectorTokensPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.helper(ctx, r.client, plan.ID.ValueString(), tokens.AccessToken, tokens.RefreshToken, &resp.State, resp.Diagnostics)
+	// tokens created before the issue time was tracked are aged from the first refresh
+	if privateState == nil {
+		privateState = &connectorTokensPrivateState{IssuedAt: time.Now().UTC()}
+	}
+
+	err := r.client.VerifyConnectorTokens(ctx, state.RefreshToken.ValueString(), state.AccessToken.ValueString())
+
+	// an unavailable API says nothing about the tokens
+	if isVerifyConnectorTokensUnavailable(err) {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateConnectorTokens)
+
+		return
+	}
+
+	privateState.Revoked = err != nil
+
+	if err != nil {
+		if !state.RotateOnRevoked.ValueBool() {
+			resp.State.RemoveResource(ctx)
+
+			resp.Diagnostics.AddWarning(
+				"can't to verify connector tokens",
+				fmt.Sprintf("can't verify connector %s tokens, assuming not valid and needs to be recreated", state.ID.ValueString()),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"can't to verify connector tokens",
+			fmt.Sprintf("can't verify connector %s tokens, they will be regenerated on the next apply", state.ID.ValueString()),
+		)
+	}
+
+	resp.Diagnostics.Append(setConnectorTokensPrivateState(ctx, resp.Private, privateState)...)
 }
 
-func (r *connectorTokens) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state connectorTokensModel
+// isVerifyConnectorTokensUnavailable reports whether the tokens couldn't be verified because the API
+// wasn't reachable, failed or throttled the request, rather than because it rejected the tokens.
+func isVerifyConnectorTokensUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	var httpErr *client.HTTPError
+	if !errors.As(err, &httpErr) {
+		return true
+	}
 
-	if resp.Diagnostics.HasError() {
+	return errors.Is(err, client.ErrTransient) || errors.Is(err, client.ErrRateLimited)
+}
+
+// ModifyPlan replaces the resource when the tokens are older than rotation_interval,
+// or when they were revoked and rotate_on_revoked is set.
+func (r *connectorTokens) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not rotate on resource creation or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
 	}
 
-	r.helper(ctx, r.client, state.ID.ValueString(), state.AccessToken.ValueString(), state.RefreshToken.ValueString(), &resp.State, resp.Diagnostics)
+	var plan connectorTokensModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	privateState, diags := getConnectorTokensPrivateState(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || privateState == nil || !needsConnectorTokensRotation(&plan, privateState, time.Now()) {
+		return
+	}
+
+	plan.AccessToken = types.StringUnknown()
+	plan.RefreshToken = types.StringUnknown()
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root(attr.AccessToken), path.Root(attr.RefreshToken))
+}
+
+func needsConnectorTokensRotation(plan *connectorTokensModel, privateState *connectorTokensPrivateState, now time.Time) bool {
+	if privateState.Revoked && plan.RotateOnRevoked.ValueBool() {
+		return true
+	}
+
+	if plan.RotationInterval.IsNull() || plan.RotationInterval.IsUnknown() {
+		return false
+	}
+
+	interval, err := utils.ParseDurationWithDays(plan.RotationInterval.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(privateState.IssuedAt.Add(interval))
 }
 
 func (r *connectorTokens) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// on update, we should re-create the resource
+	// tokens are regenerated by re-creating the resource, only the rotation settings can change in place
+	var plan, state connectorTokensModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.AccessToken = state.AccessToken
+	plan.RefreshToken = state.RefreshToken
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *connectorTokens) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -153,18 +324,6 @@ func (r *connectorTokens) Delete(ctx context.Context, req resource.DeleteRequest
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateConnectorTokens)
 }
 
-func (r *connectorTokens) helper(ctx context.Context, client *client.Client, connectorID, accessToken, refreshToken string, state *tfsdk.State, diagnostics diag.Diagnostics) {
-	err := client.VerifyConnectorTokens(ctx, refreshToken, accessToken)
-	if err != nil {
-		state.RemoveResource(ctx)
-
-		diagnostics.AddWarning(
-			"can't to verify connector tokens",
-			fmt.Sprintf("can't verify connector %s tokens, assuming not valid and needs to be recreated", connectorID),
-		)
-	}
-}
-
 func RequiresMapReplace(description string) *requiresMapReplace {
 	return &requiresMapReplace{description: description}
 }
@@ -0,0 +1,86 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeedsConnectorTokensRotation(t *testing.T) {
+	issuedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		plan     connectorTokensModel
+		private  connectorTokensPrivateState
+		now      time.Time
+		expected bool
+	}{
+		{
+			plan:     connectorTokensModel{RotationInterval: types.StringNull(), RotateOnRevoked: types.BoolNull()},
+			private:  connectorTokensPrivateState{IssuedAt: issuedAt},
+			now:      issuedAt.Add(365 * 24 * time.Hour),
+			expected: false,
+		},
+		{
+			plan:     connectorTokensModel{RotationInterval: types.StringValue("30d"), RotateOnRevoked: types.BoolNull()},
+			private:  connectorTokensPrivateState{IssuedAt: issuedAt},
+			now:      issuedAt.Add(29 * 24 * time.Hour),
+			expected: false,
+		},
+		{
+			plan:     connectorTokensModel{RotationInterval: types.StringValue("30d"), RotateOnRevoked: types.BoolNull()},
+			private:  connectorTokensPrivateState{IssuedAt: issuedAt},
+			now:      issuedAt.Add(30 * 24 * time.Hour),
+			expected: true,
+		},
+		{
+			plan:     connectorTokensModel{RotationInterval: types.StringUnknown(), RotateOnRevoked: types.BoolNull()},
+			private:  connectorTokensPrivateState{IssuedAt: issuedAt},
+			now:      issuedAt.Add(365 * 24 * time.Hour),
+			expected: false,
+		},
+		{
+			plan:     connectorTokensModel{RotationInterval: types.StringNull(), RotateOnRevoked: types.BoolValue(false)},
+			private:  connectorTokensPrivateState{IssuedAt: issuedAt, Revoked: true},
+			now:      issuedAt,
+			expected: false,
+		},
+		{
+			plan:     connectorTokensModel{RotationInterval: types.StringNull(), RotateOnRevoked: types.BoolValue(true)},
+			private:  connectorTokensPrivateState{IssuedAt: issuedAt, Revoked: true},
+			now:      issuedAt,
+			expected: true,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, needsConnectorTokensRotation(&c.plan, &c.private, c.now))
+		})
+	}
+}
+
+func TestIsVerifyConnectorTokensUnavailable(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{err: nil, expected: false},
+		{err: client.NewAPIError(client.NewHTTPError("/validate_tokens", http.StatusUnauthorized, nil), "verify", "connector tokens"), expected: false},
+		{err: client.NewAPIError(client.NewHTTPError("/validate_tokens", http.StatusTooManyRequests, nil), "verify", "connector tokens"), expected: true},
+		{err: client.NewAPIError(client.NewHTTPError("/validate_tokens", http.StatusBadGateway, nil), "verify", "connector tokens"), expected: true},
+		{err: client.NewAPIError(errors.New("can't execute http request"), "verify", "connector tokens"), expected: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, isVerifyConnectorTokensUnavailable(c.err))
+		})
+	}
+}
@@ -241,7 +241,7 @@ func (r *connector) Delete(ctx context.Context, req resource.DeleteRequest, resp
 
 func (r *connector) helper(ctx context.Context, conn *model.Connector, state *connectorModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			respState.RemoveResource(ctx)
 
@@ -0,0 +1,382 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	dnsFilteringProfileOrderID = "dns-filtering-profile-order"
+
+	defaultBasePriority = 1
+	defaultPriorityStep = 1
+	minPriorityStep     = 0.01
+)
+
+var (
+	ErrDNSFilteringProfileNotFound  = errors.New("DNS filtering profile not found")
+	ErrDNSFilteringProfileCollision = errors.New("priority is already used by a DNS filtering profile outside the order")
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var (
+	_ resource.Resource               = &dnsFilteringProfileOrder{}
+	_ resource.ResourceWithModifyPlan = &dnsFilteringProfileOrder{}
+)
+
+func NewDNSFilteringProfileOrder() resource.Resource {
+	return &dnsFilteringProfileOrder{}
+}
+
+type dnsFilteringProfileOrder struct {
+	client *client.Client
+}
+
+type dnsFilteringProfileOrderModel struct {
+	ID           types.String  `tfsdk:"id"`
+	ProfileIDs   types.List    `tfsdk:"profile_ids"`
+	BasePriority types.Float64 `tfsdk:"base_priority"`
+	PriorityStep types.Float64 `tfsdk:"priority_step"`
+	Priorities   types.Map     `tfsdk:"priorities"`
+}
+
+func (r *dnsFilteringProfileOrder) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = TwingateDNSFilteringProfileOrder
+}
+
+func (r *dnsFilteringProfileOrder) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *dnsFilteringProfileOrder) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Authoritatively assigns the priorities of DNS filtering profiles from an ordered list, e.g. to coordinate the profiles managed in different workspaces. The `%s` of the ordered profiles should be ignored with `lifecycle { ignore_changes = [%s] }` in their `%s` resources. Destroying this resource leaves the priorities unchanged.", attr.Priority, attr.Priority, TwingateDNSFilteringProfile),
+		Attributes: map[string]schema.Attribute{
+			attr.ProfileIDs: schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the DNS filtering profiles, ordered from the highest to the lowest priority.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			// optional
+			attr.BasePriority: schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     float64default.StaticFloat64(defaultBasePriority),
+				Description: fmt.Sprintf("The priority of the last profile in the list. Defaults to %d.", defaultBasePriority),
+			},
+			attr.PriorityStep: schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     float64default.StaticFloat64(defaultPriorityStep),
+				Description: fmt.Sprintf("The priority difference between adjacent profiles in the list. Must be at least %v. Defaults to %d.", minPriorityStep, defaultPriorityStep),
+				Validators: []validator.Float64{
+					float64validator.AtLeast(minPriorityStep),
+				},
+			},
+			// computed
+			attr.ID: schema.StringAttribute{
+				Computed:      true,
+				Description:   "Autogenerated ID of the DNS filtering profile order.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.Priorities: schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "The priority of each ordered DNS filtering profile by its ID.",
+			},
+		},
+	}
+}
+
+// ModifyPlan plans the priorities of the ordered profiles, fails on collisions with profiles outside of the order
+// and summarizes the reordering.
+func (r *dnsFilteringProfileOrder) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dnsFilteringProfileOrderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.ProfileIDs.IsUnknown() || plan.BasePriority.IsUnknown() || plan.PriorityStep.IsUnknown() {
+		return
+	}
+
+	// the IDs of the profiles created in the same apply are unknown
+	for _, id := range plan.ProfileIDs.Elements() {
+		if id.IsUnknown() {
+			return
+		}
+	}
+
+	order, diags := buildDNSFilteringProfileOrder(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Priorities = convertPrioritiesToTerraform(order.Priorities())
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	if r.client == nil {
+		return
+	}
+
+	profiles, err := r.client.ReadShallowDNSFilteringProfiles(ctx)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateDNSFilteringProfileOrder)
+
+		return
+	}
+
+	resp.Diagnostics.Append(checkDNSFilteringProfileOrder(order, profiles)...)
+
+	if summary := summarizeDNSFilteringProfileReorder(order, profiles); summary != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root(attr.Priorities), "DNS filtering profiles will be reordered", summary)
+	}
+}
+
+func (r *dnsFilteringProfileOrder) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsFilteringProfileOrderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics, operationCreate)
+}
+
+func (r *dnsFilteringProfileOrder) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsFilteringProfileOrderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, diags := buildDNSFilteringProfileOrder(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := r.client.ReadShallowDNSFilteringProfiles(ctx)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateDNSFilteringProfileOrder)
+
+		return
+	}
+
+	ordered := utils.MakeLookupMap(order.ProfileIDs)
+	priorities := make(map[string]float64, len(order.ProfileIDs))
+
+	for _, profile := range profiles {
+		if ordered[profile.ID] {
+			priorities[profile.ID] = model.RoundPriority(profile.Priority)
+		}
+	}
+
+	if len(priorities) == 0 {
+		// clear state
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// profiles deleted outside of Terraform or with changed priorities show up as a diff
+	state.Priorities = convertPrioritiesToTerraform(priorities)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnsFilteringProfileOrder) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsFilteringProfileOrderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.State, &resp.Diagnostics, operationUpdate)
+}
+
+func (r *dnsFilteringProfileOrder) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// the priorities are left unchanged, there is no API object to delete
+}
+
+// apply updates the priorities of the ordered profiles that don't match the order.
+func (r *dnsFilteringProfileOrder) apply(ctx context.Context, plan *dnsFilteringProfileOrderModel, state *tfsdk.State, diagnostics *diag.Diagnostics, operation string) {
+	order, diags := buildDNSFilteringProfileOrder(ctx, plan)
+	diagnostics.Append(diags...)
+
+	if diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := r.client.ReadShallowDNSFilteringProfiles(ctx)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		addErr(diagnostics, err, operation, TwingateDNSFilteringProfileOrder)
+
+		return
+	}
+
+	// profiles could have changed since the plan
+	diagnostics.Append(checkDNSFilteringProfileOrder(order, profiles)...)
+
+	if diagnostics.HasError() {
+		return
+	}
+
+	current := make(map[string]float64, len(profiles))
+	for _, profile := range profiles {
+		current[profile.ID] = model.RoundPriority(profile.Priority)
+	}
+
+	priorities := order.Priorities()
+
+	for _, id := range order.ProfileIDs {
+		if current[id] == priorities[id] {
+			continue
+		}
+
+		if err := r.client.UpdateDNSFilteringProfilePriority(ctx, id, priorities[id]); err != nil {
+			addErr(diagnostics, err, operation, TwingateDNSFilteringProfileOrder)
+
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(dnsFilteringProfileOrderID)
+	plan.Priorities = convertPrioritiesToTerraform(priorities)
+
+	diagnostics.Append(state.Set(ctx, plan)...)
+}
+
+func buildDNSFilteringProfileOrder(ctx context.Context, data *dnsFilteringProfileOrderModel) (model.DNSFilteringProfileOrder, diag.Diagnostics) {
+	var profileIDs []string
+
+	diags := data.ProfileIDs.ElementsAs(ctx, &profileIDs, false)
+
+	return model.DNSFilteringProfileOrder{
+		ProfileIDs:   profileIDs,
+		BasePriority: data.BasePriority.ValueFloat64(),
+		PriorityStep: data.PriorityStep.ValueFloat64(),
+	}, diags
+}
+
+// checkDNSFilteringProfileOrder reports the ordered profiles that don't exist and the profiles
+// outside of the order that would get the same priority as an ordered one.
+func checkDNSFilteringProfileOrder(order model.DNSFilteringProfileOrder, profiles []*model.DNSFilteringProfile) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	existing := make(map[string]bool, len(profiles))
+	for _, profile := range profiles {
+		existing[profile.ID] = true
+	}
+
+	for _, id := range order.ProfileIDs {
+		if !existing[id] {
+			diagnostics.AddAttributeError(path.Root(attr.ProfileIDs), "Invalid DNS filtering profile order",
+				fmt.Sprintf("%s: %s.", ErrDNSFilteringProfileNotFound.Error(), id))
+		}
+	}
+
+	for _, profile := range order.Collisions(profiles) {
+		diagnostics.AddAttributeError(path.Root(attr.ProfileIDs), "DNS filtering profile priority collision",
+			fmt.Sprintf("%s: %s (%s) has priority %s. Add it to the order or change its priority.",
+				ErrDNSFilteringProfileCollision.Error(), profile.Name, profile.ID, formatPriority(profile.Priority)))
+	}
+
+	return diagnostics
+}
+
+// summarizeDNSFilteringProfileReorder lists the new order of the profiles with their priority changes,
+// or returns an empty string if no priority changes.
+func summarizeDNSFilteringProfileReorder(order model.DNSFilteringProfileOrder, profiles []*model.DNSFilteringProfile) string {
+	current := make(map[string]*model.DNSFilteringProfile, len(profiles))
+	for _, profile := range profiles {
+		current[profile.ID] = profile
+	}
+
+	priorities := order.Priorities()
+	lines := make([]string, 0, len(order.ProfileIDs))
+	changed := false
+
+	for i, id := range order.ProfileIDs {
+		profile, ok := current[id]
+		if !ok {
+			continue
+		}
+
+		line := fmt.Sprintf("%d. %s (%s): %s", i+1, profile.Name, id, formatPriority(priorities[id]))
+
+		if model.RoundPriority(profile.Priority) != priorities[id] {
+			changed = true
+			line = fmt.Sprintf("%d. %s (%s): %s -> %s", i+1, profile.Name, id, formatPriority(profile.Priority), formatPriority(priorities[id]))
+		}
+
+		lines = append(lines, line)
+	}
+
+	if !changed {
+		return ""
+	}
+
+	return "New order from the highest to the lowest priority:\n" + strings.Join(lines, "\n")
+}
+
+func convertPrioritiesToTerraform(priorities map[string]float64) types.Map {
+	elements := make(map[string]tfattr.Value, len(priorities))
+	for id, priority := range priorities {
+		elements[id] = types.Float64Value(priority)
+	}
+
+	return types.MapValueMust(types.Float64Type, elements)
+}
+
+func formatPriority(priority float64) string {
+	return strconv.FormatFloat(priority, 'f', -1, 64)
+}
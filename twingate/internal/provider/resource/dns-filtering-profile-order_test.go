@@ -0,0 +1,85 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeDNSFilteringProfileReorder(t *testing.T) {
+	order := model.DNSFilteringProfileOrder{
+		ProfileIDs:   []string{"b", "a", "c"},
+		BasePriority: 1,
+		PriorityStep: 1,
+	}
+
+	profiles := []*model.DNSFilteringProfile{
+		{ID: "a", Name: "Alpha", Priority: 3},
+		{ID: "b", Name: "Beta", Priority: 2},
+		{ID: "c", Name: "Gamma", Priority: 1},
+	}
+
+	expected := "New order from the highest to the lowest priority:\n" +
+		"1. Beta (b): 2 -> 3\n" +
+		"2. Alpha (a): 3 -> 2\n" +
+		"3. Gamma (c): 1"
+
+	assert.Equal(t, expected, summarizeDNSFilteringProfileReorder(order, profiles))
+
+	profiles[0].Priority, profiles[1].Priority = 2, 3
+
+	assert.Empty(t, summarizeDNSFilteringProfileReorder(order, profiles))
+}
+
+func TestCheckDNSFilteringProfileOrder(t *testing.T) {
+	order := model.DNSFilteringProfileOrder{
+		ProfileIDs:   []string{"a", "missing"},
+		BasePriority: 1,
+		PriorityStep: 1,
+	}
+
+	profiles := []*model.DNSFilteringProfile{
+		{ID: "a", Name: "Alpha", Priority: 5},
+		{ID: "x", Name: "External", Priority: 2},
+	}
+
+	diags := checkDNSFilteringProfileOrder(order, profiles)
+
+	assert.Len(t, diags.Errors(), 2)
+	assert.Contains(t, diags.Errors()[0].Detail(), "DNS filtering profile not found: missing")
+	assert.Contains(t, diags.Errors()[1].Detail(), "External (x) has priority 2")
+}
+
+func TestDNSFilteringProfileOrderModifyPlanUnknownProfileIDs(t *testing.T) {
+	ctx := t.Context()
+	r := NewDNSFilteringProfileOrder()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	require.False(t, plan.Set(ctx, &dnsFilteringProfileOrderModel{
+		ID:           types.StringUnknown(),
+		ProfileIDs:   types.ListValueMust(types.StringType, []tfattr.Value{types.StringValue("a"), types.StringUnknown()}),
+		BasePriority: types.Float64Value(1),
+		PriorityStep: types.Float64Value(1),
+		Priorities:   types.MapUnknown(types.Float64Type),
+	}).HasError())
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	r.(resource.ResourceWithModifyPlan).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var result dnsFilteringProfileOrderModel
+
+	require.False(t, resp.Plan.Get(ctx, &result).HasError())
+	assert.True(t, result.Priorities.IsUnknown())
+}
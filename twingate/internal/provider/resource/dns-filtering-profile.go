@@ -3,6 +3,9 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
@@ -28,6 +31,14 @@ import (
 // Ensure the implementation satisfies the desired interfaces.
 var _ resource.Resource = &dnsFilteringProfile{}
 var _ resource.ResourceWithImportState = &dnsFilteringProfile{}
+var _ resource.ResourceWithModifyPlan = &dnsFilteringProfile{}
+
+const (
+	// domain changes above this size are summarized in a plan warning.
+	largeDomainsDiff = 50
+	// the number of domains listed as an example in the summary.
+	domainsDiffSample = 5
+)
 
 func NewDNSFilteringProfile() resource.Resource {
 	return &dnsFilteringProfile{}
@@ -333,6 +344,64 @@ func defaultEmptySet() types.Set {
 	return types.SetValueMust(types.StringType, []tfattr.Value{})
 }
 
+// ModifyPlan summarizes large changes of allowed or denied domains, e.g. from a parsed block list,
+// that are hard to review in the plan output.
+func (r *dnsFilteringProfile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not summarize on resource creation or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dnsFilteringProfileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if summary := summarizeDomainsDiff(state.AllowedDomains, plan.AllowedDomains); summary != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root(attr.AllowedDomains), "Large "+attr.AllowedDomains+" change", summary)
+	}
+
+	if summary := summarizeDomainsDiff(state.DeniedDomains, plan.DeniedDomains); summary != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root(attr.DeniedDomains), "Large "+attr.DeniedDomains+" change", summary)
+	}
+}
+
+func summarizeDomainsDiff(stateDomains, planDomains types.Object) string {
+	if !planDomains.IsNull() && !planDomains.IsUnknown() && planDomains.Attributes()[attr.Domains].IsUnknown() {
+		return ""
+	}
+
+	oldDomains, newDomains := convertDomains(stateDomains), convertDomains(planDomains)
+	added, removed := setDifference(newDomains, oldDomains), setDifference(oldDomains, newDomains)
+
+	if len(added)+len(removed) <= largeDomainsDiff {
+		return ""
+	}
+
+	return fmt.Sprintf("%d domains will be added%s and %d removed%s, %d domains in total.",
+		len(added), domainsSample(added), len(removed), domainsSample(removed), len(newDomains))
+}
+
+func domainsSample(domains []string) string {
+	if len(domains) == 0 {
+		return ""
+	}
+
+	sorted := append([]string{}, domains...)
+	sort.Strings(sorted)
+
+	sample := strings.Join(sorted[:min(len(sorted), domainsDiffSample)], ", ")
+	if len(sorted) > domainsDiffSample {
+		sample += ", ..."
+	}
+
+	return " (" + sample + ")"
+}
+
 func (r *dnsFilteringProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan dnsFilteringProfileModel
 
@@ -473,7 +542,7 @@ func (r *dnsFilteringProfile) Delete(ctx context.Context, req resource.DeleteReq
 
 func (r *dnsFilteringProfile) helper(ctx context.Context, profile *model.DNSFilteringProfile, state *dnsFilteringProfileModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			respState.RemoveResource(ctx)
 
@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeDomainsDiff(t *testing.T) {
+	domains := func(count int, prefix string) types.Object {
+		items := make([]string, 0, count)
+		for i := range count {
+			items = append(items, fmt.Sprintf("%s%03d.example.com", prefix, i))
+		}
+
+		return convertDomainsToTerraform(items, types.BoolValue(true))
+	}
+
+	unknownDomains := types.ObjectValueMust(domainsAttributeTypes(), map[string]tfattr.Value{
+		attr.IsAuthoritative: types.BoolValue(true),
+		attr.Domains:         types.SetUnknown(types.StringType),
+	})
+
+	cases := []struct {
+		state    types.Object
+		plan     types.Object
+		expected string
+	}{
+		{
+			state:    domains(10, "a"),
+			plan:     domains(40, "a"),
+			expected: "",
+		},
+		{
+			state:    domains(10, "a"),
+			plan:     domains(70, "a"),
+			expected: "60 domains will be added (a010.example.com, a011.example.com, a012.example.com, a013.example.com, a014.example.com, ...) and 0 removed, 70 domains in total.",
+		},
+		{
+			state:    domains(30, "a"),
+			plan:     domains(30, "b"),
+			expected: "30 domains will be added (b000.example.com, b001.example.com, b002.example.com, b003.example.com, b004.example.com, ...) and 30 removed (a000.example.com, a001.example.com, a002.example.com, a003.example.com, a004.example.com, ...), 30 domains in total.",
+		},
+		{
+			state:    domains(100, "a"),
+			plan:     unknownDomains,
+			expected: "",
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, summarizeDomainsDiff(c.state, c.plan))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// ephemeral keys expire after a day, in case Terraform can't close them.
+	defaultEphemeralServiceKeyExpirationTime = 1
+	maxServiceKeyExpirationTime              = 365
+
+	privateServiceKey = "service_key"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource          = &ephemeralServiceKey{}
+	_ ephemeral.EphemeralResourceWithClose = &ephemeralServiceKey{}
+)
+
+func NewEphemeralServiceKey() ephemeral.EphemeralResourceWithConfigure {
+	return &ephemeralServiceKey{}
+}
+
+type ephemeralServiceKey struct {
+	client *client.Client
+}
+
+type ephemeralServiceKeyModel struct {
+	ID               types.String `tfsdk:"id"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Name             types.String `tfsdk:"name"`
+	ExpirationTime   types.Int64  `tfsdk:"expiration_time"`
+	Token            types.String `tfsdk:"token"`
+}
+
+type ephemeralServiceKeyPrivateData struct {
+	ID string `json:"id"`
+}
+
+func (r *ephemeralServiceKey) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = TwingateServiceAccountKey
+}
+
+func (r *ephemeralServiceKey) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *ephemeralServiceKey) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a short-lived Service Key for a Service Account. The key is created when Terraform opens the ephemeral resource and revoked and deleted when it is closed, so the token is never written to the state or plan.",
+		Attributes: map[string]schema.Attribute{
+			attr.ServiceAccountID: schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the Service Account",
+			},
+			// optional
+			attr.Name: schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Service Key",
+			},
+			attr.ExpirationTime: schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Specifies how many days until the Service Key expires, in case it isn't revoked when Terraform closes the ephemeral resource. This should be an integer between 0 and %d. Defaults to %d.", maxServiceKeyExpirationTime, defaultEphemeralServiceKeyExpirationTime),
+				Validators: []validator.Int64{
+					int64validator.Between(0, maxServiceKeyExpirationTime),
+				},
+			},
+			// computed
+			attr.ID: schema.StringAttribute{
+				Computed:    true,
+				Description: "Autogenerated Service Key ID",
+			},
+			attr.Token: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Autogenerated Service Key token. Used to configure a Twingate Client running in headless mode.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralServiceKey) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client not configured",
+			"The provider client is nil. Please report this issue to the provider developers.",
+		)
+
+		return
+	}
+
+	// Read configuration from the request
+	var plan ephemeralServiceKeyModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expirationTime := defaultEphemeralServiceKeyExpirationTime
+	if !plan.ExpirationTime.IsNull() {
+		expirationTime = int(plan.ExpirationTime.ValueInt64())
+	}
+
+	serviceKey, err := r.client.CreateServiceKey(ctx, &model.ServiceKey{
+		Service:        plan.ServiceAccountID.ValueString(),
+		Name:           plan.Name.ValueString(),
+		ExpirationTime: expirationTime,
+	})
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateServiceAccountKey)
+
+		return
+	}
+
+	privateData, err := json.Marshal(ephemeralServiceKeyPrivateData{ID: serviceKey.ID})
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateServiceAccountKey)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateServiceKey, privateData)...)
+
+	plan.ID = types.StringValue(serviceKey.ID)
+	plan.Name = types.StringValue(serviceKey.Name)
+	plan.Token = types.StringValue(serviceKey.Token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, plan)...)
+}
+
+// Close revokes and deletes the Service Key created by Open.
+func (r *ephemeralServiceKey) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.client == nil {
+		return
+	}
+
+	privateData, diags := req.Private.GetKey(ctx, privateServiceKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || len(privateData) == 0 {
+		return
+	}
+
+	var data ephemeralServiceKeyPrivateData
+	if err := json.Unmarshal(privateData, &data); err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, TwingateServiceAccountKey)
+
+		return
+	}
+
+	if err := r.client.RevokeServiceKey(ctx, data.ID); err != nil && !errors.Is(err, client.ErrNotFound) {
+		addErr(&resp.Diagnostics, err, operationDelete, TwingateServiceAccountKey)
+
+		return
+	}
+
+	if err := r.client.DeleteServiceKey(ctx, data.ID); err != nil && !errors.Is(err, client.ErrNotFound) {
+		addErr(&resp.Diagnostics, err, operationDelete, TwingateServiceAccountKey)
+	}
+}
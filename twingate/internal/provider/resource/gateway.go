@@ -167,7 +167,7 @@ func (r *gateway) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 func (r *gateway) helper(ctx context.Context, gateway *model.Gateway, state *gatewayModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			respState.RemoveResource(ctx)
 
 			return
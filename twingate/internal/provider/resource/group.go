@@ -37,10 +37,11 @@ type group struct {
 }
 
 type groupModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	IsAuthoritative types.Bool   `tfsdk:"is_authoritative"`
-	UserIDs         types.Set    `tfsdk:"user_ids"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	IsAuthoritative    types.Bool   `tfsdk:"is_authoritative"`
+	UserIDs            types.Set    `tfsdk:"user_ids"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *group) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -101,6 +102,10 @@ func (r *group) Schema(_ context.Context, _ resource.SchemaRequest, resp *resour
 				Optional:    true,
 				Description: "List of User IDs that have permission to access the Group.",
 			},
+			attr.DeletionProtection: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Prevents the Group from being destroyed by Terraform. Default is `false`.",
+			},
 			// computed
 			attr.ID: schema.StringAttribute{
 				Computed:      true,
@@ -193,6 +198,10 @@ func (r *group) Delete(ctx context.Context, req resource.DeleteRequest, resp *re
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, TwingateGroup, &resp.Diagnostics) {
+		return
+	}
+
 	if _, err := r.isAllowedToChangeGroup(ctx, state.ID.ValueString()); err != nil {
 		addErr(&resp.Diagnostics, err, operationDelete, TwingateGroup)
 
@@ -218,7 +227,7 @@ func (r *group) isAllowedToChangeGroup(ctx context.Context, groupID string) (*mo
 
 func (r *group) helper(ctx context.Context, group *model.Group, state *groupModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			respState.RemoveResource(ctx)
 
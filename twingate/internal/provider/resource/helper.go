@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -130,6 +132,21 @@ func addErr(diagnostics *diag.Diagnostics, err error, operation, resource string
 	)
 }
 
+// isDeletionProtected adds an error diagnostic when deletion_protection is enabled in the state.
+func isDeletionProtected(deletionProtection types.Bool, resource string, diagnostics *diag.Diagnostics) bool {
+	if !deletionProtection.ValueBool() {
+		return false
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root(attr.DeletionProtection),
+		fmt.Sprintf("failed to %s %s", operationDelete, resource),
+		fmt.Sprintf("%s has deletion protection enabled. Set `%s = false` and apply before destroying it.", resource, attr.DeletionProtection),
+	)
+
+	return true
+}
+
 func makeNullObject(attributeTypes map[string]tfattr.Type) types.Object {
 	return types.ObjectNull(attributeTypes)
 }
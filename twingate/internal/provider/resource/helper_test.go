@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -179,6 +181,14 @@ func TestIsWildcardAddress(t *testing.T) {
 			address:  "10.0.0.0/16",
 			expected: true,
 		},
+		{
+			address:  "10.0.0.1-10.0.0.50",
+			expected: true,
+		},
+		{
+			address:  "10.0.0.1",
+			expected: false,
+		},
 	}
 
 	for n, c := range cases {
@@ -187,3 +197,46 @@ func TestIsWildcardAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestFindOverlappingResources(t *testing.T) {
+	resources := []*model.Resource{
+		{ID: "1", Address: "10.0.0.0/16"},
+		{ID: "2", Address: "10.1.2.3"},
+		{ID: "3", Address: "acc-test.com"},
+		{ID: "4", Address: "10.0.0.1-10.0.0.20"},
+	}
+
+	cases := []struct {
+		resourceID string
+		address    string
+		expected   []string
+	}{
+		{
+			address:  "10.0.0.0/8",
+			expected: []string{"1", "2", "4"},
+		},
+		{
+			resourceID: "1",
+			address:    "10.0.0.0/16",
+			expected:   []string{"4"},
+		},
+		{
+			address:  "10.2.0.0/16",
+			expected: nil,
+		},
+		{
+			address:  "acc-test.com",
+			expected: nil,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual := utils.Map(findOverlappingResources(c.resourceID, c.address, resources), func(item *model.Resource) string {
+				return item.ID
+			})
+
+			assert.ElementsMatch(t, c.expected, actual)
+		})
+	}
+}
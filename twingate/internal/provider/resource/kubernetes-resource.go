@@ -386,7 +386,7 @@ func (r *kubernetesResource) Delete(ctx context.Context, req resource.DeleteRequ
 //nolint:funlen
 func (r *kubernetesResource) helper(ctx context.Context, k8sRes *model.KubernetesResource, state *kubernetesResourceModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			respState.RemoveResource(ctx)
 
 			return
@@ -35,10 +35,11 @@ type remoteNetwork struct {
 }
 
 type remoteNetworkModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Location types.String `tfsdk:"location"`
-	Type     types.String `tfsdk:"type"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Location           types.String `tfsdk:"location"`
+	Type               types.String `tfsdk:"type"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *remoteNetwork) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,13 +84,17 @@ func (r *remoteNetwork) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			attr.Type: schema.StringAttribute{
 				Optional:      true,
 				Computed:      true,
-				Description:   fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s. Defaults to %s.", strings.Join([]string{model.NetworkTypeRegular, model.NetworkTypeExit}, ", "), model.NetworkTypeRegular),
+				Description:   fmt.Sprintf("The type of the Remote Network. Must be one of the following: %s. Defaults to %s.", strings.Join(model.NetworkTypes, ", "), model.NetworkTypeRegular),
 				Default:       stringdefault.StaticString(model.NetworkTypeRegular),
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Validators: []validator.String{
-					stringvalidator.OneOf(model.NetworkTypeRegular, model.NetworkTypeExit),
+					stringvalidator.OneOf(model.NetworkTypes...),
 				},
 			},
+			attr.DeletionProtection: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Prevents the Remote Network from being destroyed by Terraform. Default is `false`.",
+			},
 			// computed
 			attr.ID: schema.StringAttribute{
 				Computed:      true,
@@ -167,13 +172,34 @@ func (r *remoteNetwork) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteRemoteNetwork(ctx, state.ID.ValueString())
+	if isDeletionProtected(state.DeletionProtection, TwingateRemoteNetwork, &resp.Diagnostics) {
+		return
+	}
+
+	dependencies, err := r.client.ReadRemoteNetworkDependencies(ctx, state.ID.ValueString())
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationDelete, TwingateRemoteNetwork)
+
+		return
+	}
+
+	if !dependencies.IsEmpty() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to %s %s", operationDelete, TwingateRemoteNetwork),
+			fmt.Sprintf("Remote Network %s still has dependent objects, delete or move them first:\n  - %s",
+				state.ID.ValueString(), strings.Join(dependencies.Describe(), "\n  - ")),
+		)
+
+		return
+	}
+
+	err = r.client.DeleteRemoteNetwork(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateRemoteNetwork)
 }
 
 func (r *remoteNetwork) helper(ctx context.Context, network *model.RemoteNetwork, state *remoteNetworkModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			respState.RemoveResource(ctx)
 
@@ -50,6 +50,7 @@ var (
 	ErrInvalidAttributeCombination        = errors.New("invalid attribute combination")
 	ErrWildcardAddressWithEnabledShortcut = errors.New("Resources with a CIDR range or wildcard can't have the browser shortcut enabled.")
 	ErrWrongGlobalID                      = errors.New("Unable to parse global ID")
+	ErrResourceOnExitNetwork              = errors.New("Resources can't be assigned to an " + model.NetworkTypeExit + " Remote Network.")
 )
 
 // Ensure the implementation satisfies the desired interfaces.
@@ -81,6 +82,7 @@ type resourceModel struct {
 	SecurityPolicyID         types.String `tfsdk:"security_policy_id"`
 	Tags                     types.Map    `tfsdk:"tags"`
 	TagsAll                  types.Map    `tfsdk:"tags_all"`
+	DeletionProtection       types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *twingateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -130,6 +132,63 @@ func (r *twingateResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 	// the API default values (mode=MANUAL, approval_mode=MANUAL, no duration).
 	// ModifyPlan runs after all attribute-level plan modifiers, so this override is final.
 	suppressAccessPolicyDefaultDrift(ctx, req, resp)
+
+	r.warnOverlappingAddress(ctx, req, resp)
+}
+
+// warnOverlappingAddress adds a warning when the planned IP based address overlaps with
+// the address of another Resource in the same Remote Network. Only the resource cache is used.
+func (r *twingateResource) warnOverlappingAddress(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var plan resourceModel
+
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	if plan.Address.IsUnknown() || plan.RemoteNetworkID.IsUnknown() {
+		return
+	}
+
+	resources := r.client.ReadCachedResourcesByRemoteNetwork(ctx, plan.RemoteNetworkID.ValueString())
+
+	for _, overlap := range findOverlappingResources(plan.ID.ValueString(), plan.Address.ValueString(), resources) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(attr.Address),
+			"Overlapping Resource address",
+			fmt.Sprintf("Address %q overlaps with address %q of Resource %q (%s) in the same Remote Network.",
+				plan.Address.ValueString(), overlap.Address, overlap.Name, overlap.ID),
+		)
+	}
+}
+
+func findOverlappingResources(resourceID, address string, resources []*model.Resource) []*model.Resource {
+	planned, err := utils.ParseAddress(address)
+	if err != nil || !planned.IsIPBased() {
+		return nil
+	}
+
+	var overlaps []*model.Resource
+
+	for _, res := range resources {
+		if res.ID == resourceID {
+			continue
+		}
+
+		existing, err := utils.ParseAddress(res.Address)
+		if err != nil {
+			continue
+		}
+
+		if planned.Overlaps(existing) {
+			overlaps = append(overlaps, res)
+		}
+	}
+
+	return overlaps
 }
 
 // suppressAccessPolicyDefaultDrift prevents spurious drift after import: Twingate always
@@ -258,7 +317,10 @@ func (r *twingateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			attr.Address: schema.StringAttribute{
 				Required:    true,
-				Description: "The Resource's IP/CIDR or FQDN/DNS zone",
+				Description: "The Resource's IP/CIDR, IP range or FQDN/DNS zone",
+				Validators: []validator.String{
+					customvalidator.ResourceAddress(),
+				},
 			},
 			attr.RemoteNetworkID: schema.StringAttribute{
 				Required:    true,
@@ -284,6 +346,10 @@ func (r *twingateResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				PlanModifiers: []planmodifier.String{customplanmodifier.CaseInsensitiveDiff()},
 			},
 			attr.Protocols: protocols(),
+			attr.DeletionProtection: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Prevents the Resource from being destroyed by Terraform. Default is `false`.",
+			},
 			attr.Tags: schema.MapAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -599,6 +665,12 @@ func (r *twingateResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if err := r.checkRemoteNetworkType(ctx, input.RemoteNetworkID); err != nil {
+		addErr(&resp.Diagnostics, err, operationCreate, TwingateResource)
+
+		return
+	}
+
 	resource, err := r.client.CreateResource(ctx, input)
 	if err != nil {
 		addErr(&resp.Diagnostics, err, operationCreate, TwingateResource)
@@ -1030,6 +1102,14 @@ func (r *twingateResource) Update(ctx context.Context, req resource.UpdateReques
 	planSecurityPolicy := input.SecurityPolicyID
 	input.ID = state.ID.ValueString()
 
+	if !plan.RemoteNetworkID.Equal(state.RemoteNetworkID) {
+		if err := r.checkRemoteNetworkType(ctx, input.RemoteNetworkID); err != nil {
+			addErr(&resp.Diagnostics, err, operationUpdate, TwingateResource)
+
+			return
+		}
+	}
+
 	if !plan.GroupAccess.Equal(state.GroupAccess) || !plan.ServiceAccess.Equal(state.ServiceAccess) {
 		if err := r.updateResourceAccess(ctx, &plan, &state, input); err != nil {
 			addErr(&resp.Diagnostics, err, operationUpdate, TwingateResource)
@@ -1057,6 +1137,20 @@ func (r *twingateResource) Update(ctx context.Context, req resource.UpdateReques
 	r.helper(ctx, resource, &state, &plan, &resp.State, &resp.Diagnostics, err, operationUpdate)
 }
 
+// checkRemoteNetworkType rejects exit Remote Networks, they only route Internet traffic.
+func (r *twingateResource) checkRemoteNetworkType(ctx context.Context, remoteNetworkID string) error {
+	network, err := r.client.ReadRemoteNetworkByID(ctx, remoteNetworkID)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if network.Type == model.NetworkTypeExit {
+		return fmt.Errorf("%w Remote Network %s is of type %s.", ErrResourceOnExitNetwork, remoteNetworkID, network.Type)
+	}
+
+	return nil
+}
+
 func isResourceChanged(plan, state *resourceModel) bool {
 	return !plan.RemoteNetworkID.Equal(state.RemoteNetworkID) ||
 		!plan.Name.Equal(state.Name) ||
@@ -1146,13 +1240,17 @@ func (r *twingateResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if isDeletionProtected(state.DeletionProtection, TwingateResource, &resp.Diagnostics) {
+		return
+	}
+
 	err := r.client.DeleteResource(ctx, state.ID.ValueString())
 	addErr(&resp.Diagnostics, err, operationDelete, TwingateResource)
 }
 
 func (r *twingateResource) helper(ctx context.Context, resource *model.Resource, state, reference *resourceModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			respState.RemoveResource(ctx)
 
@@ -1262,6 +1360,7 @@ func setState(ctx context.Context, state, reference *resourceModel, resource *mo
 	state.ServiceAccess = serviceAccess
 	state.TagsAll = utils.ConvertMapValue(resource.Tags)
 	state.Tags = reference.Tags
+	state.DeletionProtection = reference.DeletionProtection
 }
 
 func convertProtocolsToTerraform(protocols *model.Protocols, reference *types.Object) (types.Object, diag.Diagnostics) {
@@ -1627,10 +1726,13 @@ func convertGroupsAccessToTerraformForImport(ctx context.Context, groupAccess []
 	return makeObjectsSet(ctx, objects...)
 }
 
-var cidrRgxp = regexp.MustCompile(`(\d{1,3}\.){3}\d{1,3}(/\d+)`)
-
 func isWildcardAddress(address string) bool {
-	return strings.ContainsAny(address, "*?") || cidrRgxp.MatchString(address)
+	parsed, err := utils.ParseAddress(address)
+	if err != nil {
+		return strings.ContainsAny(address, "*?")
+	}
+
+	return parsed.IsWildcard()
 }
 
 func accessGroupAttributeTypes() map[string]tfattr.Type {
@@ -131,7 +131,7 @@ func (r *serviceAccount) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *serviceAccount) helper(ctx context.Context, serviceAccount *model.ServiceAccount, state *serviceAccountModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			// clear state
 			respState.RemoveResource(ctx)
 
@@ -0,0 +1,532 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	defaultKeyRotationOverlap     = "24h"
+	defaultKeyRotationGenerations = 2
+	maxKeyRotationGenerations     = 10
+)
+
+var ErrInvalidRotationOverlap = errors.New("overlap must be shorter than rotation_period")
+
+// Ensure the implementation satisfies the desired interfaces.
+var (
+	_ resource.Resource                   = &serviceKeyRotation{}
+	_ resource.ResourceWithModifyPlan     = &serviceKeyRotation{}
+	_ resource.ResourceWithValidateConfig = &serviceKeyRotation{}
+)
+
+func NewServiceKeyRotationResource() resource.Resource {
+	return &serviceKeyRotation{}
+}
+
+type serviceKeyRotation struct {
+	client *client.Client
+}
+
+type serviceKeyRotationModel struct {
+	ID               types.String `tfsdk:"id"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Name             types.String `tfsdk:"name"`
+	RotationPeriod   types.String `tfsdk:"rotation_period"`
+	Overlap          types.String `tfsdk:"overlap"`
+	Generations      types.Int64  `tfsdk:"generations"`
+	CurrentKeyID     types.String `tfsdk:"current_key_id"`
+	CurrentToken     types.String `tfsdk:"current_token"`
+	PreviousKeyID    types.String `tfsdk:"previous_key_id"`
+	PreviousToken    types.String `tfsdk:"previous_token"`
+	RotatedAt        types.String `tfsdk:"rotated_at"`
+	Keys             types.List   `tfsdk:"keys"`
+}
+
+type serviceKeyRotationKeyModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+func serviceKeyRotationKeyAttributeTypes() map[string]tfattr.Type {
+	return map[string]tfattr.Type{
+		attr.ID:     types.StringType,
+		attr.Name:   types.StringType,
+		attr.Status: types.StringType,
+	}
+}
+
+func (r *serviceKeyRotation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = TwingateServiceAccountKeyRotation
+}
+
+func (r *serviceKeyRotation) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+//nolint:funlen
+func (r *serviceKeyRotation) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Keeps a rolling set of Service Keys for a Service Account. A new key is created on the first apply after `rotation_period` has elapsed, and the key it replaces is revoked once the `overlap` window has passed, so headless Clients can switch to the new token without downtime.",
+		Attributes: map[string]schema.Attribute{
+			attr.ServiceAccountID: schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the Service Account",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			attr.RotationPeriod: schema.StringAttribute{
+				Required:    true,
+				Description: "How often a new Service Key is created. Duration must be between 1 hour and 365 days. Examples of valid values include `12h` and `30d`.",
+				Validators: []validator.String{
+					customvalidator.Duration(),
+				},
+			},
+			// optional
+			attr.Name: schema.StringAttribute{
+				Optional:    true,
+				Description: "The name prefix of the Service Keys. Each key is named `<name>-<timestamp>` using the UTC time of its creation.",
+			},
+			attr.Overlap: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultKeyRotationOverlap),
+				Description: fmt.Sprintf("How long the previous Service Key stays active after a rotation, e.g. `30m`. Must be shorter than `%s`. Defaults to `%s`.", attr.RotationPeriod, defaultKeyRotationOverlap),
+				Validators: []validator.String{
+					customvalidator.NonNegativeDuration(),
+				},
+			},
+			attr.Generations: schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultKeyRotationGenerations),
+				Description: fmt.Sprintf("The number of Service Keys, including revoked ones, kept for the Service Account. Older keys are deleted. Must be between %d and %d. Defaults to %d.", defaultKeyRotationGenerations, maxKeyRotationGenerations, defaultKeyRotationGenerations),
+				Validators: []validator.Int64{
+					int64validator.Between(defaultKeyRotationGenerations, maxKeyRotationGenerations),
+				},
+			},
+			// computed
+			attr.ID: schema.StringAttribute{
+				Computed:      true,
+				Description:   "The id of the Service Account",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.CurrentKeyID: schema.StringAttribute{
+				Computed:      true,
+				Description:   "The id of the most recently created Service Key.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.CurrentToken: schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The token of the most recently created Service Key. Used to configure a Twingate Client running in headless mode.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.PreviousKeyID: schema.StringAttribute{
+				Computed:      true,
+				Description:   "The id of the Service Key replaced by the last rotation.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.PreviousToken: schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The token of the Service Key replaced by the last rotation. Set to null once the key is revoked.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.RotatedAt: schema.StringAttribute{
+				Computed:      true,
+				Description:   "The time of the last rotation in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			attr.Keys: schema.ListNestedAttribute{
+				Computed:      true,
+				Description:   "The Service Keys kept for the Service Account, from the newest to the oldest.",
+				PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "The id of the Service Key.",
+						},
+						attr.Name: schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Service Key.",
+						},
+						attr.Status: schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the Service Key.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceKeyRotation) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rotationPeriod, overlap types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.RotationPeriod), &rotationPeriod)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr.Overlap), &overlap)...)
+
+	if resp.Diagnostics.HasError() || rotationPeriod.IsNull() || rotationPeriod.IsUnknown() || overlap.IsUnknown() {
+		return
+	}
+
+	overlapValue := withDefaultValue(overlap.ValueString(), defaultKeyRotationOverlap)
+
+	period, periodErr := utils.ParseDurationWithDays(rotationPeriod.ValueString())
+	overlapDuration, overlapErr := utils.ParseDurationWithDays(overlapValue)
+
+	// invalid durations are reported by the attribute validators
+	if periodErr != nil || overlapErr != nil {
+		return
+	}
+
+	if overlapDuration >= period {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attr.Overlap),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s: got overlap %s and rotation_period %s.", ErrInvalidRotationOverlap.Error(), overlapValue, rotationPeriod.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan marks the computed attributes as unknown when the next apply has to rotate, revoke or delete keys.
+func (r *serviceKeyRotation) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state serviceKeyRotationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || plan.RotationPeriod.IsUnknown() || plan.Overlap.IsUnknown() || plan.Generations.IsUnknown() {
+		return
+	}
+
+	rotation, diags := buildServiceKeyRotation(ctx, &plan, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || !rotation.NeedsUpdate(time.Now()) {
+		return
+	}
+
+	plan.CurrentKeyID = types.StringUnknown()
+	plan.CurrentToken = types.StringUnknown()
+	plan.PreviousKeyID = types.StringUnknown()
+	plan.PreviousToken = types.StringUnknown()
+	plan.RotatedAt = types.StringUnknown()
+	plan.Keys = types.ListUnknown(types.ObjectType{AttrTypes: serviceKeyRotationKeyAttributeTypes()})
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *serviceKeyRotation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serviceKeyRotationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.rotate(ctx, &plan, nil, &resp.State, &resp.Diagnostics, operationCreate)
+}
+
+func (r *serviceKeyRotation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serviceKeyRotationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, diags := convertServiceKeysFromTerraform(ctx, state.Keys)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refreshed := make([]*model.ServiceKey, 0, len(keys))
+
+	for _, key := range keys {
+		serviceKey, err := r.client.ReadServiceKey(ctx, key.ID)
+		if err != nil {
+			// keys deleted outside of Terraform are dropped
+			if errors.Is(err, client.ErrNotFound) {
+				continue
+			}
+
+			addErr(&resp.Diagnostics, err, operationRead, TwingateServiceAccountKeyRotation)
+
+			return
+		}
+
+		refreshed = append(refreshed, serviceKey)
+	}
+
+	if len(refreshed) == 0 {
+		// clear state
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	rotation := &model.ServiceKeyRotation{Keys: refreshed}
+	setServiceKeyRotationState(ctx, rotation, &state, &resp.State, &resp.Diagnostics)
+}
+
+func (r *serviceKeyRotation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serviceKeyRotationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.rotate(ctx, &plan, &state, &resp.State, &resp.Diagnostics, operationUpdate)
+}
+
+func (r *serviceKeyRotation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serviceKeyRotationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, diags := convertServiceKeysFromTerraform(ctx, state.Keys)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, key := range keys {
+		if err := r.deleteKey(ctx, key); err != nil {
+			addErr(&resp.Diagnostics, err, operationDelete, TwingateServiceAccountKeyRotation)
+
+			return
+		}
+	}
+}
+
+// rotate creates a new key when the rotation period has elapsed, revokes the keys past their
+// overlap window and deletes the keys exceeding the number of generations.
+func (r *serviceKeyRotation) rotate(ctx context.Context, plan, state *serviceKeyRotationModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, operation string) {
+	rotation, diags := buildServiceKeyRotation(ctx, plan, state)
+	diagnostics.Append(diags...)
+
+	if diagnostics.HasError() {
+		return
+	}
+
+	plan.CurrentToken = types.StringNull()
+	plan.PreviousToken = types.StringNull()
+
+	if state != nil {
+		plan.CurrentToken = state.CurrentToken
+		plan.PreviousToken = state.PreviousToken
+	}
+
+	now := time.Now().UTC()
+
+	if rotation.NeedsRotation(now) {
+		serviceKey, err := r.client.CreateServiceKey(ctx, &model.ServiceKey{
+			Service: plan.ServiceAccountID.ValueString(),
+			Name:    serviceKeyRotationName(plan.Name, now),
+		})
+		if err != nil {
+			addErr(diagnostics, err, operation, TwingateServiceAccountKeyRotation)
+
+			return
+		}
+
+		rotation.Keys = append([]*model.ServiceKey{serviceKey}, rotation.Keys...)
+		rotation.RotatedAt = now
+		plan.PreviousToken = plan.CurrentToken
+		plan.CurrentToken = types.StringValue(serviceKey.Token)
+	}
+
+	// the state is saved even if the cleanup fails, so that the new key is not lost
+	defer setServiceKeyRotationState(ctx, rotation, plan, respState, diagnostics)
+
+	for _, key := range rotation.KeysToRevoke(now) {
+		if err := r.client.RevokeServiceKey(ctx, key.ID); err != nil {
+			addErr(diagnostics, err, operation, TwingateServiceAccountKeyRotation)
+
+			return
+		}
+
+		key.Status = model.StatusRevoked
+	}
+
+	for _, key := range rotation.KeysToDelete() {
+		if err := r.deleteKey(ctx, key); err != nil {
+			addErr(diagnostics, err, operation, TwingateServiceAccountKeyRotation)
+
+			return
+		}
+	}
+
+	rotation.Keys = rotation.Keys[:min(len(rotation.Keys), rotation.Generations)]
+}
+
+func (r *serviceKeyRotation) deleteKey(ctx context.Context, key *model.ServiceKey) error {
+	if key.IsActive() {
+		if err := r.client.RevokeServiceKey(ctx, key.ID); err != nil && !errors.Is(err, client.ErrNotFound) {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	if err := r.client.DeleteServiceKey(ctx, key.ID); err != nil && !errors.Is(err, client.ErrNotFound) {
+		return err //nolint:wrapcheck
+	}
+
+	return nil
+}
+
+func serviceKeyRotationName(prefix types.String, now time.Time) string {
+	if prefix.ValueString() == "" {
+		return ""
+	}
+
+	return prefix.ValueString() + "-" + now.Format("20060102150405")
+}
+
+// buildServiceKeyRotation combines the rotation settings from the plan with the keys kept in the state.
+func buildServiceKeyRotation(ctx context.Context, plan, state *serviceKeyRotationModel) (*model.ServiceKeyRotation, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	rotationPeriod, err := utils.ParseDurationWithDays(plan.RotationPeriod.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root(attr.RotationPeriod), "failed to parse "+attr.RotationPeriod, err.Error())
+	}
+
+	overlap, err := utils.ParseDurationWithDays(withDefaultValue(plan.Overlap.ValueString(), defaultKeyRotationOverlap))
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root(attr.Overlap), "failed to parse "+attr.Overlap, err.Error())
+	}
+
+	rotation := &model.ServiceKeyRotation{
+		RotationPeriod: rotationPeriod,
+		Overlap:        overlap,
+		Generations:    int(plan.Generations.ValueInt64()),
+	}
+
+	if state == nil {
+		return rotation, diagnostics
+	}
+
+	keys, diags := convertServiceKeysFromTerraform(ctx, state.Keys)
+	diagnostics.Append(diags...)
+	rotation.Keys = keys
+
+	if !state.RotatedAt.IsNull() && !state.RotatedAt.IsUnknown() {
+		rotation.RotatedAt, err = time.Parse(time.RFC3339, state.RotatedAt.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root(attr.RotatedAt), "failed to parse "+attr.RotatedAt, err.Error())
+		}
+	}
+
+	return rotation, diagnostics
+}
+
+func convertServiceKeysFromTerraform(ctx context.Context, list types.List) ([]*model.ServiceKey, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var keys []serviceKeyRotationKeyModel
+
+	diags := list.ElementsAs(ctx, &keys, false)
+
+	return utils.Map(keys, func(key serviceKeyRotationKeyModel) *model.ServiceKey {
+		return &model.ServiceKey{
+			ID:     key.ID.ValueString(),
+			Name:   key.Name.ValueString(),
+			Status: key.Status.ValueString(),
+		}
+	}), diags
+}
+
+func setServiceKeyRotationState(ctx context.Context, rotation *model.ServiceKeyRotation, state *serviceKeyRotationModel, respState *tfsdk.State, diagnostics *diag.Diagnostics) {
+	keys := utils.Map(rotation.Keys, func(key *model.ServiceKey) serviceKeyRotationKeyModel {
+		return serviceKeyRotationKeyModel{
+			ID:     types.StringValue(key.ID),
+			Name:   types.StringValue(key.Name),
+			Status: types.StringValue(key.Status),
+		}
+	})
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serviceKeyRotationKeyAttributeTypes()}, keys)
+	diagnostics.Append(diags...)
+
+	state.ID = state.ServiceAccountID
+	state.Keys = list
+	state.CurrentKeyID = types.StringNull()
+	state.PreviousKeyID = types.StringNull()
+
+	if current := rotation.Current(); current != nil {
+		state.CurrentKeyID = types.StringValue(current.ID)
+	}
+
+	if previous := rotation.Previous(); previous != nil {
+		state.PreviousKeyID = types.StringValue(previous.ID)
+
+		if !previous.IsActive() {
+			state.PreviousToken = types.StringNull()
+		}
+	} else {
+		state.PreviousToken = types.StringNull()
+	}
+
+	if !rotation.RotatedAt.IsZero() {
+		state.RotatedAt = types.StringValue(rotation.RotatedAt.Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diagnostics.Append(respState.Set(ctx, state)...)
+}
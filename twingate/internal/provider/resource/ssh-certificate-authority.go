@@ -137,7 +137,7 @@ func (r *sshCertificateAuthority) Delete(ctx context.Context, req resource.Delet
 
 func (r *sshCertificateAuthority) helper(ctx context.Context, certificateAuthority *model.CertificateAuthority, state *sshCertificateAuthorityModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			respState.RemoveResource(ctx)
 
 			return
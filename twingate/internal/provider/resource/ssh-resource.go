@@ -310,7 +310,7 @@ func (r *sshResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 //nolint:funlen
 func (r *sshResource) helper(ctx context.Context, sshRes *model.SSHResource, state *sshResourceModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			respState.RemoveResource(ctx)
 
 			return
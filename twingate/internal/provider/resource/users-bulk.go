@@ -0,0 +1,651 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	usersBulkID = "users-bulk"
+
+	// maxConcurrentUserRequests limits the parallel user requests, rate limited requests are retried by the client.
+	maxConcurrentUserRequests = 5
+
+	importEmailsSeparator = ","
+)
+
+var (
+	ErrUsersBulkDuplicateEmail = errors.New("email is used by more than one user")
+	ErrUsersBulkUserNotFound   = errors.New("user not found")
+)
+
+// Ensure the implementation satisfies the desired interfaces.
+var (
+	_ resource.Resource                = &usersBulk{}
+	_ resource.ResourceWithImportState = &usersBulk{}
+	_ resource.ResourceWithModifyPlan  = &usersBulk{}
+)
+
+func NewUsersBulkResource() resource.Resource {
+	return &usersBulk{}
+}
+
+type usersBulk struct {
+	client *client.Client
+}
+
+type usersBulkModel struct {
+	ID    types.String         `tfsdk:"id"`
+	Users []usersBulkUserModel `tfsdk:"users"`
+}
+
+type usersBulkUserModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Role      types.String `tfsdk:"role"`
+	GroupIDs  types.Set    `tfsdk:"group_ids"`
+}
+
+func (r *usersBulk) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = TwingateUsersBulk
+}
+
+func (r *usersBulk) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*providerdata.ProviderData)
+	if !ok {
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ImportState imports the users by a comma-separated list of emails.
+func (r *usersBulk) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	emails := utils.Filter(utils.Map(strings.Split(req.ID, importEmailsSeparator), strings.TrimSpace), func(email string) bool {
+		return email != ""
+	})
+
+	users, err := r.client.ReadUsers(ctx, nil)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, "import", TwingateUsersBulk)
+
+		return
+	}
+
+	existing := make(map[string]*model.User, len(users))
+	for _, user := range users {
+		existing[strings.ToLower(user.Email)] = user
+	}
+
+	state := usersBulkModel{
+		ID:    types.StringValue(usersBulkID),
+		Users: make([]usersBulkUserModel, 0, len(emails)),
+	}
+
+	for _, email := range emails {
+		user, ok := existing[strings.ToLower(email)]
+		if !ok {
+			addErr(&resp.Diagnostics, fmt.Errorf("%w: %s", ErrUsersBulkUserNotFound, email), "import", TwingateUsersBulk)
+
+			continue
+		}
+
+		state.Users = append(state.Users, usersBulkUserModel{
+			ID:        types.StringValue(user.ID),
+			Email:     types.StringValue(user.Email),
+			FirstName: types.StringValue(user.FirstName),
+			LastName:  types.StringValue(user.LastName),
+			Role:      types.StringValue(user.Role),
+			GroupIDs:  types.SetNull(types.StringType),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *usersBulk) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a list of Users, e.g. decoded from a CSV or JSON file with `csvdecode` or `jsondecode`. Users are matched by email: users added to the list are created, changed ones are updated and users removed from the list are deleted. A failure to create or update a single User is reported as a warning and retried on the next apply, without aborting the rest of the list. A failure to delete a User is reported as an error, and the User is kept in the state to be deleted on the next apply. A failed change of group memberships is reported as an error, and the previous memberships are kept in the state. The resource can be imported with a comma-separated list of emails. For more information, see Twingate's [documentation](https://www.twingate.com/docs/users).",
+		Attributes: map[string]schema.Attribute{
+			attr.Users: schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The Users to manage.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						attr.Email: schema.StringAttribute{
+							Required:    true,
+							Description: "The User's email address. Changing it replaces the User.",
+						},
+						attr.FirstName: schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The User's first name",
+						},
+						attr.LastName: schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The User's last name",
+						},
+						attr.Role: schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(model.DefaultUserRole),
+							Description: fmt.Sprintf("Determines the User's role. Either %s. Defaults to `%s`.", utils.DocList(model.UserRoles), model.DefaultUserRole),
+							Validators: []validator.String{
+								stringvalidator.OneOf(model.UserRoles...),
+							},
+						},
+						attr.GroupIDs: schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the groups the User is added to. Removing a group from the set removes the User from the group. Memberships removed outside of this resource are added again, while the groups the User is added to outside of this resource are ignored.",
+						},
+						// computed
+						attr.ID: schema.StringAttribute{
+							Computed:    true,
+							Description: "Autogenerated ID of the User, encoded in base64. Null if the User failed to be created.",
+						},
+					},
+				},
+			},
+			// computed
+			attr.ID: schema.StringAttribute{
+				Computed:      true,
+				Description:   "Autogenerated ID of the list of Users.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// ModifyPlan matches the planned users with the current ones by email, so that only the users
+// added to the list or not created yet get unknown IDs.
+func (r *usersBulk) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var users types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attr.Users), &users)...)
+
+	if resp.Diagnostics.HasError() || users.IsUnknown() {
+		return
+	}
+
+	var plan, state usersBulkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkUsersBulkEmails(plan.Users)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := usersBulkByEmail(state.Users)
+
+	for i, user := range plan.Users {
+		existing, ok := current[strings.ToLower(user.Email.ValueString())]
+		if user.Email.IsUnknown() || !ok || existing.ID.IsNull() {
+			plan.Users[i].ID = types.StringUnknown()
+
+			continue
+		}
+
+		plan.Users[i].ID = existing.ID
+
+		if user.FirstName.IsUnknown() {
+			plan.Users[i].FirstName = existing.FirstName
+		}
+
+		if user.LastName.IsUnknown() {
+			plan.Users[i].LastName = existing.LastName
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *usersBulk) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan usersBulkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, nil, &resp.State, &resp.Diagnostics, operationCreate)
+}
+
+func (r *usersBulk) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state usersBulkModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := r.client.ReadUsers(ctx, nil)
+	if err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateUsersBulk)
+
+		return
+	}
+
+	existing := make(map[string]*model.User, len(users))
+	for _, user := range users {
+		existing[user.ID] = user
+	}
+
+	for i, user := range state.Users {
+		if user.ID.IsNull() {
+			continue
+		}
+
+		current, ok := existing[user.ID.ValueString()]
+		if !ok {
+			// users deleted outside of Terraform are created again
+			state.Users[i].ID = types.StringNull()
+
+			continue
+		}
+
+		state.Users[i].FirstName = types.StringValue(current.FirstName)
+		state.Users[i].LastName = types.StringValue(current.LastName)
+		state.Users[i].Role = types.StringValue(current.Role)
+	}
+
+	if err := r.refreshGroupMemberships(ctx, state.Users); err != nil {
+		addErr(&resp.Diagnostics, err, operationRead, TwingateUsersBulk)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refreshGroupMemberships keeps in the group_ids of the users only the groups they are still members of,
+// so that the memberships removed outside of this resource are added again. The groups the users were
+// added to outside of this resource are ignored.
+func (r *usersBulk) refreshGroupMemberships(ctx context.Context, users []usersBulkUserModel) error {
+	tracked := utils.Filter(users, func(user usersBulkUserModel) bool {
+		return !user.ID.IsNull() && len(user.GroupIDs.Elements()) > 0
+	})
+
+	if len(tracked) == 0 {
+		return nil
+	}
+
+	groups, err := r.client.ReadGroups(ctx, nil)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	keepGroupMemberships(users, groups)
+
+	return nil
+}
+
+// keepGroupMemberships removes from the group_ids of the users the groups they aren't members of.
+func keepGroupMemberships(users []usersBulkUserModel, groups []*model.Group) {
+	memberships := make(map[string]map[string]bool)
+
+	for _, group := range groups {
+		for _, userID := range group.Users {
+			if memberships[userID] == nil {
+				memberships[userID] = make(map[string]bool)
+			}
+
+			memberships[userID][group.ID] = true
+		}
+	}
+
+	for i, user := range users {
+		if user.ID.IsNull() || user.GroupIDs.IsNull() {
+			continue
+		}
+
+		groupIDs := utils.Filter(convertSetToList(user.GroupIDs), func(groupID string) bool {
+			return memberships[user.ID.ValueString()][groupID]
+		})
+
+		users[i].GroupIDs = convertStringListToSet(groupIDs)
+	}
+}
+
+func (r *usersBulk) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state usersBulkModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, state.Users, &resp.State, &resp.Diagnostics, operationUpdate)
+}
+
+func (r *usersBulk) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state usersBulkModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users := utils.Filter(state.Users, func(user usersBulkUserModel) bool {
+		return !user.ID.IsNull()
+	})
+
+	errs := forEachBulkUser(users, func(user *usersBulkUserModel) error {
+		return r.client.DeleteUser(ctx, user.ID.ValueString())
+	})
+
+	addUsersBulkErrors(&resp.Diagnostics, users, errs, operationDelete)
+}
+
+// apply reconciles the current users with the planned ones. Failures to create or update single users are reported
+// as warnings, failed users keep a null ID and are created again on the next apply. Failures to delete users are
+// reported as errors, and the users are kept in the state.
+func (r *usersBulk) apply(ctx context.Context, plan *usersBulkModel, current []usersBulkUserModel, state *tfsdk.State, diagnostics *diag.Diagnostics, operation string) {
+	planned := usersBulkByEmail(plan.Users)
+
+	removed := utils.Filter(current, func(user usersBulkUserModel) bool {
+		_, ok := planned[strings.ToLower(user.Email.ValueString())]
+
+		return !ok && !user.ID.IsNull()
+	})
+
+	errs := forEachBulkUser(removed, func(user *usersBulkUserModel) error {
+		return r.client.DeleteUser(ctx, user.ID.ValueString())
+	})
+	addUsersBulkErrors(diagnostics, removed, errs, operationDelete)
+
+	// the users which failed to be deleted are kept in the state and deleted again on the next apply
+	undeleted := failedBulkUsers(removed, errs)
+
+	existing := usersBulkByEmail(current)
+
+	errs = forEachBulkUser(plan.Users, func(user *usersBulkUserModel) error {
+		if currentUser, ok := existing[strings.ToLower(user.Email.ValueString())]; ok && !currentUser.ID.IsNull() {
+			user.ID = currentUser.ID
+
+			return r.updateBulkUser(ctx, currentUser, user)
+		}
+
+		return r.createBulkUser(ctx, user)
+	})
+	addUsersBulkWarnings(diagnostics, plan.Users, errs, operation)
+
+	// the memberships of the removed users are gone with them
+	kept := utils.Filter(current, func(user usersBulkUserModel) bool {
+		_, ok := planned[strings.ToLower(user.Email.ValueString())]
+
+		return ok
+	})
+
+	failed := r.updateGroupMemberships(ctx, kept, plan.Users, diagnostics, operation)
+
+	// the failed memberships are changed again on the next apply
+	for i, user := range plan.Users {
+		if !failed[user.ID.ValueString()] {
+			continue
+		}
+
+		plan.Users[i].GroupIDs = types.SetNull(types.StringType)
+		if currentUser, ok := existing[strings.ToLower(user.Email.ValueString())]; ok {
+			plan.Users[i].GroupIDs = currentUser.GroupIDs
+		}
+	}
+
+	plan.Users = append(plan.Users, undeleted...)
+	plan.ID = types.StringValue(usersBulkID)
+
+	diagnostics.Append(state.Set(ctx, plan)...)
+}
+
+func (r *usersBulk) createBulkUser(ctx context.Context, user *usersBulkUserModel) error {
+	created, err := r.client.CreateUser(ctx, &model.User{
+		Email:     user.Email.ValueString(),
+		FirstName: user.FirstName.ValueString(),
+		LastName:  user.LastName.ValueString(),
+		Role:      withDefaultValue(user.Role.ValueString(), model.DefaultUserRole),
+	})
+	if err != nil {
+		user.ID = types.StringNull()
+
+		if user.FirstName.IsUnknown() {
+			user.FirstName = types.StringValue("")
+		}
+
+		if user.LastName.IsUnknown() {
+			user.LastName = types.StringValue("")
+		}
+
+		return err //nolint:wrapcheck
+	}
+
+	user.ID = types.StringValue(created.ID)
+
+	if user.FirstName.IsUnknown() {
+		user.FirstName = types.StringValue(created.FirstName)
+	}
+
+	if user.LastName.IsUnknown() {
+		user.LastName = types.StringValue(created.LastName)
+	}
+
+	return nil
+}
+
+func (r *usersBulk) updateBulkUser(ctx context.Context, current, user *usersBulkUserModel) error {
+	update := &model.UserUpdate{
+		ID: current.ID.ValueString(),
+	}
+
+	if user.FirstName.ValueString() != "" && current.FirstName != user.FirstName {
+		update.FirstName = user.FirstName.ValueStringPointer()
+	}
+
+	if user.LastName.ValueString() != "" && current.LastName != user.LastName {
+		update.LastName = user.LastName.ValueStringPointer()
+	}
+
+	if user.Role.ValueString() != "" && current.Role != user.Role {
+		update.Role = user.Role.ValueStringPointer()
+	}
+
+	if update.FirstName == nil && update.LastName == nil && update.Role == nil {
+		return nil
+	}
+
+	_, err := r.client.UpdateUser(ctx, update)
+
+	return err //nolint:wrapcheck
+}
+
+// updateGroupMemberships adds and removes the users of each group in a single request per group,
+// and returns the IDs of the users whose memberships failed to change.
+func (r *usersBulk) updateGroupMemberships(ctx context.Context, current, planned []usersBulkUserModel, diagnostics *diag.Diagnostics, operation string) map[string]bool {
+	added, removed := model.UserGroupChanges(convertBulkUsersToModel(current), convertBulkUsersToModel(planned))
+	failed := make(map[string]bool)
+
+	for _, groupID := range sortedKeys(added) {
+		if err := r.client.AddGroupUsers(ctx, groupID, added[groupID]); err != nil {
+			addErr(diagnostics, err, operation, TwingateUsersBulk)
+
+			for _, userID := range added[groupID] {
+				failed[userID] = true
+			}
+		}
+	}
+
+	for _, groupID := range sortedKeys(removed) {
+		if err := r.client.DeleteGroupUsers(ctx, groupID, removed[groupID]); err != nil {
+			addErr(diagnostics, err, operation, TwingateUsersBulk)
+
+			for _, userID := range removed[groupID] {
+				failed[userID] = true
+			}
+		}
+	}
+
+	return failed
+}
+
+// forEachBulkUser calls fn for each user concurrently, limited to maxConcurrentUserRequests,
+// and returns the errors by the index of the user.
+func forEachBulkUser(users []usersBulkUserModel, fn func(user *usersBulkUserModel) error) []error {
+	errs := make([]error, len(users))
+
+	group := errgroup.Group{}
+	group.SetLimit(maxConcurrentUserRequests)
+
+	for i := range users {
+		group.Go(func() error {
+			errs[i] = fn(&users[i])
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	return errs
+}
+
+func addUsersBulkWarnings(diagnostics *diag.Diagnostics, users []usersBulkUserModel, errs []error, operation string) {
+	for i, err := range errs {
+		if err != nil {
+			diagnostics.AddWarning(
+				fmt.Sprintf("failed to %s %s", operation, TwingateUsersBulk),
+				fmt.Sprintf("%s: %s", users[i].Email.ValueString(), err.Error()),
+			)
+		}
+	}
+}
+
+func addUsersBulkErrors(diagnostics *diag.Diagnostics, users []usersBulkUserModel, errs []error, operation string) {
+	for i, err := range errs {
+		if err != nil {
+			addErr(diagnostics, fmt.Errorf("%s: %w", users[i].Email.ValueString(), err), operation, TwingateUsersBulk)
+		}
+	}
+}
+
+// failedBulkUsers returns the users with an error.
+func failedBulkUsers(users []usersBulkUserModel, errs []error) []usersBulkUserModel {
+	failed := make([]usersBulkUserModel, 0, len(users))
+
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, users[i])
+		}
+	}
+
+	return failed
+}
+
+// checkUsersBulkEmails reports the emails used by more than one user, ignoring the case.
+func checkUsersBulkEmails(users []usersBulkUserModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	seen := make(map[string]bool, len(users))
+
+	for i, user := range users {
+		if user.Email.IsUnknown() {
+			continue
+		}
+
+		email := strings.ToLower(user.Email.ValueString())
+		if seen[email] {
+			diagnostics.AddAttributeError(path.Root(attr.Users).AtListIndex(i).AtName(attr.Email), "Invalid users list",
+				fmt.Sprintf("%s: %s.", ErrUsersBulkDuplicateEmail.Error(), user.Email.ValueString()))
+		}
+
+		seen[email] = true
+	}
+
+	return diagnostics
+}
+
+func usersBulkByEmail(users []usersBulkUserModel) map[string]*usersBulkUserModel {
+	byEmail := make(map[string]*usersBulkUserModel, len(users))
+
+	for i := range users {
+		byEmail[strings.ToLower(users[i].Email.ValueString())] = &users[i]
+	}
+
+	return byEmail
+}
+
+func convertBulkUsersToModel(users []usersBulkUserModel) []*model.User {
+	return utils.Map(users, func(user usersBulkUserModel) *model.User {
+		return &model.User{
+			ID: user.ID.ValueString(),
+			Groups: utils.Map(user.GroupIDs.Elements(), func(item tfattr.Value) string {
+				return item.(types.String).ValueString()
+			}),
+		}
+	})
+}
+
+func sortedKeys(items map[string][]string) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
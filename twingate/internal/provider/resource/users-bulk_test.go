@@ -0,0 +1,92 @@
+package resource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUsersBulkEmails(t *testing.T) {
+	users := []usersBulkUserModel{
+		{Email: types.StringValue("john@example.com")},
+		{Email: types.StringUnknown()},
+		{Email: types.StringValue("jane@example.com")},
+		{Email: types.StringValue("John@Example.com")},
+	}
+
+	diags := checkUsersBulkEmails(users)
+
+	assert.Len(t, diags.Errors(), 1)
+	assert.Contains(t, diags.Errors()[0].Detail(), "email is used by more than one user: John@Example.com")
+
+	assert.False(t, checkUsersBulkEmails(users[:3]).HasError())
+}
+
+func TestForEachBulkUser(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	users := make([]usersBulkUserModel, 2*maxConcurrentUserRequests+1)
+	for i := range users {
+		users[i].Email = types.StringValue("user@example.com")
+	}
+
+	users[3].Email = types.StringValue("failed@example.com")
+
+	errs := forEachBulkUser(users, func(user *usersBulkUserModel) error {
+		if user.Email.ValueString() == "failed@example.com" {
+			return errFailed
+		}
+
+		user.ID = types.StringValue("created")
+
+		return nil
+	})
+
+	for i, user := range users {
+		if i == 3 {
+			assert.ErrorIs(t, errs[i], errFailed)
+			assert.True(t, user.ID.IsNull())
+
+			continue
+		}
+
+		assert.NoError(t, errs[i])
+		assert.Equal(t, "created", user.ID.ValueString())
+	}
+}
+
+func TestFailedBulkUsers(t *testing.T) {
+	users := []usersBulkUserModel{
+		{ID: types.StringValue("user-1"), Email: types.StringValue("deleted@example.com")},
+		{ID: types.StringValue("user-2"), Email: types.StringValue("failed@example.com")},
+	}
+
+	failed := failedBulkUsers(users, []error{nil, errors.New("failed")})
+
+	assert.Equal(t, []usersBulkUserModel{users[1]}, failed)
+	assert.Equal(t, "user-2", failed[0].ID.ValueString())
+}
+
+func TestKeepGroupMemberships(t *testing.T) {
+	users := []usersBulkUserModel{
+		{ID: types.StringValue("user-1"), GroupIDs: convertStringListToSet([]string{"group-1", "group-2"})},
+		{ID: types.StringValue("user-2"), GroupIDs: types.SetNull(types.StringType)},
+		{ID: types.StringNull(), GroupIDs: convertStringListToSet([]string{"group-1"})},
+	}
+
+	groups := []*model.Group{
+		{ID: "group-1", Users: []string{"user-1", "user-2"}},
+		{ID: "group-2", Users: []string{"user-2"}},
+		{ID: "group-3", Users: []string{"user-1"}},
+	}
+
+	keepGroupMemberships(users, groups)
+
+	// user-1 was removed from group-2 outside of Terraform, group-3 isn't managed
+	assert.Equal(t, convertStringListToSet([]string{"group-1"}), users[0].GroupIDs)
+	assert.True(t, users[1].GroupIDs.IsNull())
+	assert.Equal(t, convertStringListToSet([]string{"group-1"}), users[2].GroupIDs)
+}
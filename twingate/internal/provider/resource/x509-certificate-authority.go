@@ -152,7 +152,7 @@ func (r *x509CertificateAuthority) Delete(ctx context.Context, req resource.Dele
 
 func (r *x509CertificateAuthority) helper(ctx context.Context, certificateAuthority *model.CertificateAuthority, state *x509CertificateAuthorityModel, respState *tfsdk.State, diagnostics *diag.Diagnostics, err error, operation string) {
 	if err != nil {
-		if errors.Is(err, client.ErrGraphqlResultIsEmpty) {
+		if errors.Is(err, client.ErrNotFound) {
 			respState.RemoveResource(ctx)
 
 			return
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	"github.com/google/go-cmp/cmp"
@@ -334,3 +335,143 @@ func TestAccDatasourceTwingateConnectorsFilterByRegexp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDatasourceTwingateConnectorsFilterByRemoteNetworkType(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	connectorName := test.RandomConnectorName()
+	theDatasource := "data.twingate_connectors." + resourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateConnectorsFilterByRemoteNetworkType(resourceName, test.RandomName(), connectorName),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, connectorsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, connectorNamePath, connectorName),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Connectors, attr.RemoteNetworkType), model.NetworkTypeExit),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateConnectorsFilterByRemoteNetworkType(resourceName, networkName, connectorName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s_network" {
+		name = "%[2]s"
+		type = "EXIT"
+	}
+	resource "twingate_connector" "%[1]s_connector" {
+		remote_network_id = twingate_remote_network.%[1]s_network.id
+		name = "%[3]s"
+	}
+
+	data "twingate_connectors" "%[1]s" {
+		name = "%[3]s"
+		remote_network_type = "EXIT"
+		depends_on = [twingate_connector.%[1]s_connector]
+	}
+	`, resourceName, networkName, connectorName)
+}
+
+func TestAccDatasourceTwingateConnectorsFilterByRemoteNetwork(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	networkName := test.RandomName()
+	connectorName := test.RandomConnectorName()
+	theDatasource := "data.twingate_connectors." + resourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateConnectorsFilterByRemoteNetwork(resourceName, networkName, connectorName, attr.RemoteNetworkID, "twingate_remote_network."+resourceName+"_network.id"),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, connectorsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, connectorNamePath, connectorName),
+				),
+			},
+			{
+				Config: testDatasourceTwingateConnectorsFilterByRemoteNetwork(resourceName, networkName, connectorName, attr.RemoteNetworkName, "twingate_remote_network."+resourceName+"_network.name"),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, connectorsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, connectorNamePath, connectorName),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateConnectorsFilterByRemoteNetwork(resourceName, networkName, connectorName, filter, value string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s_network" {
+		name = "%[2]s"
+	}
+	resource "twingate_connector" "%[1]s_connector" {
+		remote_network_id = twingate_remote_network.%[1]s_network.id
+		name = "%[3]s"
+	}
+
+	data "twingate_connectors" "%[1]s" {
+		%[4]s = %[5]s
+		depends_on = [twingate_connector.%[1]s_connector]
+	}
+	`, resourceName, networkName, connectorName, filter, value)
+}
+
+func TestAccDatasourceTwingateConnectorsFilterByState(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	networkName := test.RandomName()
+	connectorName := test.RandomConnectorName()
+	theDatasource := "data.twingate_connectors." + resourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// a freshly created connector was never deployed, so it has no heartbeat
+				Config: testDatasourceTwingateConnectorsFilterByState(resourceName, networkName, connectorName, model.ConnectorStateAlive),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, connectorsLen, "0"),
+				),
+			},
+			{
+				Config: testDatasourceTwingateConnectorsFilterByState(resourceName, networkName, connectorName, model.ConnectorStateDeadNoHeartbeat),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, connectorsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, connectorNamePath, connectorName),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateConnectorsFilterByState(resourceName, networkName, connectorName, state string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s_network" {
+		name = "%[2]s"
+	}
+	resource "twingate_connector" "%[1]s_connector" {
+		remote_network_id = twingate_remote_network.%[1]s_network.id
+		name = "%[3]s"
+	}
+
+	data "twingate_connectors" "%[1]s" {
+		name = "%[3]s"
+		states = ["%[4]s"]
+		depends_on = [twingate_connector.%[1]s_connector]
+	}
+	`, resourceName, networkName, connectorName, state)
+}
@@ -0,0 +1,64 @@
+package datasource
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const domainListContent = `
+# hosts
+0.0.0.0 localhost
+0.0.0.0 Ads.Example.com tracker.example.com
+! adblock
+||ads.example.com^
+||cdn.example.net^$third-party
+plain.example.org.
+not_a_domain
+`
+
+func TestAccDatasourceTwingateDNSFilteringDomainList(t *testing.T) {
+	t.Parallel()
+
+	terraformResourceName := test.TerraformRandName("dts_domain_list")
+	theDatasource := "data.twingate_dns_filtering_domain_list." + terraformResourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: datasourceDNSFilteringDomainList(terraformResourceName, false),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, attr.DomainsCount, "3"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Domains), "3"),
+					resource.TestCheckTypeSetElemAttr(theDatasource, attr.Domains+".*", "ads.example.com"),
+					resource.TestCheckTypeSetElemAttr(theDatasource, attr.Domains+".*", "plain.example.org"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.InvalidDomains), "1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.SkippedCount, "1"),
+					resource.TestCheckResourceAttrSet(theDatasource, attr.Hash),
+				),
+			},
+			{
+				Config:      datasourceDNSFilteringDomainList(terraformResourceName, true),
+				ExpectError: regexp.MustCompile("the domain list contains invalid domains"),
+			},
+		},
+	})
+}
+
+func datasourceDNSFilteringDomainList(terraformResourceName string, strict bool) string {
+	return fmt.Sprintf(`
+	data "twingate_dns_filtering_domain_list" "%s" {
+	  content = <<-EOT
+	%s
+	  EOT
+	  strict = %v
+	}
+	`, terraformResourceName, domainListContent, strict)
+}
@@ -58,3 +58,72 @@ func testDatasourceTwingateDNSFilteringProfile(testName, profileName string) str
 	}
 		`, testName, profileName)
 }
+
+func TestAccDatasourceTwingateDNSFilteringProfiles_groupCoverage(t *testing.T) {
+	t.Parallel()
+
+	testName := "t" + acctest.RandString(6)
+	prefix := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateDNSFilteringProfiles(testName, prefix),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckOutput("profiles_count", "2"),
+					resource.TestCheckOutput("shared_group_profiles", "2"),
+					resource.TestCheckOutput("uncovered_group", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateDNSFilteringProfiles(testName, prefix string) string {
+	return fmt.Sprintf(`
+	resource "twingate_group" "%[1]s_shared" {
+	  name = "%[2]s_shared"
+	}
+
+	resource "twingate_group" "%[1]s_uncovered" {
+	  name = "%[2]s_uncovered"
+	}
+
+	resource "twingate_dns_filtering_profile" "%[1]s_1" {
+	  name = "%[2]s_1"
+	  priority = 201
+	  groups = [twingate_group.%[1]s_shared.id]
+	}
+
+	resource "twingate_dns_filtering_profile" "%[1]s_2" {
+	  name = "%[2]s_2"
+	  priority = 200
+	  groups = [twingate_group.%[1]s_shared.id]
+	}
+
+	data "twingate_dns_filtering_profiles" "%[1]s" {
+	  name_prefix = "%[2]s"
+
+	  depends_on = [
+	    twingate_dns_filtering_profile.%[1]s_1,
+	    twingate_dns_filtering_profile.%[1]s_2,
+	    twingate_group.%[1]s_uncovered,
+	  ]
+	}
+
+	output "profiles_count" {
+	  value = length(data.twingate_dns_filtering_profiles.%[1]s.dns_filtering_profiles)
+	}
+
+	output "shared_group_profiles" {
+	  value = length(one([for group in data.twingate_dns_filtering_profiles.%[1]s.overlapping_groups : group.profile_ids if group.group_id == twingate_group.%[1]s_shared.id]))
+	}
+
+	output "uncovered_group" {
+	  value = contains(data.twingate_dns_filtering_profiles.%[1]s.uncovered_groups, twingate_group.%[1]s_uncovered.id)
+	}
+	`, testName, prefix)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"testing"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -190,3 +191,43 @@ func TestAccDatasourceTwingateRemoteNetworksFilterByRegexp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDatasourceTwingateRemoteNetworksFilterByType(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	networkName := test.RandomName()
+	theDatasource := "data.twingate_remote_networks." + resourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateRemoteNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateRemoteNetworksFilterByType(resourceName, networkName, model.NetworkTypeExit),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, remoteNetworksLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, remoteNetworkNamePath, networkName),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.RemoteNetworks, attr.Type), model.NetworkTypeExit),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateRemoteNetworksFilterByType(resourceName, networkName, networkType string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[2]s"
+	  type = "%[3]s"
+	}
+
+	data "twingate_remote_networks" "%[1]s" {
+	  name = "%[2]s"
+	  type = "%[3]s"
+
+	  depends_on = [twingate_remote_network.%[1]s]
+	}
+	`, resourceName, networkName, networkType)
+}
@@ -0,0 +1,93 @@
+package datasource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	conflictsLen      = attr.Len(attr.Conflicts)
+	conflictTypePath  = attr.Path(attr.Conflicts, attr.Type)
+	conflictAddrPath  = attr.Path(attr.Conflicts, attr.ResourceAddress)
+	conflictOtherPath = attr.Path(attr.Conflicts, attr.ConflictingResourceAddress)
+)
+
+func TestAccDatasourceTwingateResourceConflicts_addressOverlap(t *testing.T) {
+	t.Parallel()
+
+	terraformName := test.TerraformRandName("conflicts")
+	networkName := test.RandomName()
+	theDatasource := "data.twingate_resource_conflicts." + terraformName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateResourceConflicts(terraformName, networkName, "10.0.0.0/16", "10.0.1.5", model.ConflictTypeAddressOverlap),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, conflictsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, conflictTypePath, model.ConflictTypeAddressOverlap),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceTwingateResourceConflicts_shadowedWildcard(t *testing.T) {
+	t.Parallel()
+
+	terraformName := test.TerraformRandName("conflicts")
+	networkName := test.RandomName()
+	theDatasource := "data.twingate_resource_conflicts." + terraformName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateResourceConflicts(terraformName, networkName, "*.corp.acc-test.com", "db.corp.acc-test.com", model.ConflictTypeShadowedWildcard),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, conflictsLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, conflictAddrPath, "*.corp.acc-test.com"),
+					resource.TestCheckResourceAttr(theDatasource, conflictOtherPath, "db.corp.acc-test.com"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateResourceConflicts(terraformName, networkName, address1, address2, conflictType string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_resource" "%[1]s_1" {
+	  name              = "%[2]s-1"
+	  address           = "%[3]s"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	}
+
+	resource "twingate_resource" "%[1]s_2" {
+	  name              = "%[2]s-2"
+	  address           = "%[4]s"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	}
+
+	data "twingate_resource_conflicts" "%[1]s" {
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	  conflict_types    = ["%[5]s"]
+
+	  depends_on = [twingate_resource.%[1]s_1, twingate_resource.%[1]s_2]
+	}
+	`, terraformName, networkName, address1, address2, conflictType)
+}
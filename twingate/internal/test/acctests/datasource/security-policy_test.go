@@ -168,3 +168,71 @@ func testDatasourceTwingateSecurityPolicyByName(name string) string {
 	}
 	`, name)
 }
+
+func TestAccDatasourceTwingateSecurityPolicyIncludeUsage(t *testing.T) {
+	t.Parallel()
+
+	securityPolicies, err := acctests.ListSecurityPolicies()
+	if err != nil {
+		t.Skip("can't run test:", err)
+	}
+
+	testPolicy := securityPolicies[0]
+	testName := "t" + acctest.RandString(6)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck: func() {
+			acctests.PreCheck(t)
+		},
+		CheckDestroy: acctests.CheckTwingateResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDatasourceTwingateSecurityPolicyWithUsage(testName, testPolicy.ID),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckOutput("resource_uses_policy", "true"),
+					resource.TestCheckOutput("access_group_uses_policy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testDatasourceTwingateSecurityPolicyWithUsage(testName, policyID string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%[1]s" {
+	  name = "%[1]s"
+	}
+
+	resource "twingate_group" "%[1]s" {
+	  name = "%[1]s"
+	}
+
+	resource "twingate_resource" "%[1]s" {
+	  name = "%[1]s"
+	  address = "acc-test.com"
+	  remote_network_id = twingate_remote_network.%[1]s.id
+	  security_policy_id = "%[2]s"
+
+	  access_group {
+	    group_id = twingate_group.%[1]s.id
+	    security_policy_id = "%[2]s"
+	  }
+	}
+
+	data "twingate_security_policy" "%[1]s" {
+	  id = "%[2]s"
+	  include_usage = true
+
+	  depends_on = [twingate_resource.%[1]s]
+	}
+
+	output "resource_uses_policy" {
+	  value = contains(data.twingate_security_policy.%[1]s.resource_ids, twingate_resource.%[1]s.id)
+	}
+
+	output "access_group_uses_policy" {
+	  value = contains([for access in data.twingate_security_policy.%[1]s.access_groups : access.group_id if access.resource_id == twingate_resource.%[1]s.id], twingate_group.%[1]s.id)
+	}
+	`, testName, policyID)
+}
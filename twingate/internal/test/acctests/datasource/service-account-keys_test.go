@@ -0,0 +1,72 @@
+package datasource
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	serviceKeysLen    = attr.Len(attr.Keys)
+	serviceKeyStatus  = attr.Path(attr.Keys, attr.Status)
+	serviceKeyExpires = attr.Path(attr.Keys, attr.DaysUntilExpiry)
+)
+
+func TestAccDatasourceTwingateServiceAccountKeys(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountName := test.RandomName()
+	terraformResourceName := test.TerraformRandName("dts_keys")
+	theDatasource := "data.twingate_service_account_keys." + terraformResourceName
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: datasourceServiceAccountKeys(terraformResourceName, serviceAccountName, `expiring_within = "30d"`),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, serviceKeysLen, "1"),
+					resource.TestCheckResourceAttr(theDatasource, serviceKeyStatus, model.StatusActive),
+					resource.TestCheckResourceAttrSet(theDatasource, serviceKeyExpires),
+				),
+			},
+			{
+				Config: datasourceServiceAccountKeys(terraformResourceName, serviceAccountName, fmt.Sprintf(`status = "%s"`, model.StatusRevoked)),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, serviceKeysLen, "0"),
+				),
+			},
+		},
+	})
+}
+
+func datasourceServiceAccountKeys(terraformResourceName, serviceAccountName, filter string) string {
+	return fmt.Sprintf(`
+	resource "twingate_service_account" "%[1]s" {
+	  name = "%[2]s"
+	}
+
+	resource "twingate_service_account_key" "%[1]s_expiring" {
+	  service_account_id = twingate_service_account.%[1]s.id
+	  expiration_time = 7
+	}
+
+	resource "twingate_service_account_key" "%[1]s_permanent" {
+	  service_account_id = twingate_service_account.%[1]s.id
+	}
+
+	data "twingate_service_account_keys" "%[1]s" {
+	  service_account_id = twingate_service_account.%[1]s.id
+	  %[3]s
+
+	  depends_on = [twingate_service_account_key.%[1]s_expiring, twingate_service_account_key.%[1]s_permanent]
+	}
+	`, terraformResourceName, serviceAccountName, filter)
+}
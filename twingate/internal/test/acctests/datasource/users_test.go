@@ -702,3 +702,49 @@ func terraformDatasourceUsersByEmailAndFirstNameLastNamePrefixAndRole(prefix, re
 	}
 `, prefix, role, resourceName)
 }
+
+func TestAccDatasourceTwingateUsers_filterByGroupMembership(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomName()
+	groupName := test.RandomGroupName()
+	email := test.RandomEmail()
+	const theDatasource = "data.twingate_users.filter_by_group"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: terraformDatasourceUsersByGroup(resourceName, email, groupName),
+				Check: acctests.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Users), "1"),
+					resource.TestCheckResourceAttr(theDatasource, attr.Path(attr.Users, attr.Email), email),
+					resource.TestCheckResourceAttr(theDatasource, attr.Len(attr.Users, attr.GroupIDs), "1"),
+					resource.TestCheckTypeSetElemAttrPair(theDatasource, attr.Path(attr.Users, attr.GroupIDs)+".*", "twingate_group."+resourceName, attr.ID),
+				),
+			},
+		},
+	})
+}
+
+func terraformDatasourceUsersByGroup(resourceName, email, groupName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_user" "%[1]s" {
+	  email = "%[2]s"
+	  send_invite = false
+	}
+
+	resource "twingate_group" "%[1]s" {
+	  name = "%[3]s"
+	  user_ids = [twingate_user.%[1]s.id]
+	}
+
+	data "twingate_users" "filter_by_group" {
+	  member_of_group = twingate_group.%[1]s.id
+	  state = "ACTIVE"
+
+	  depends_on = [twingate_group.%[1]s]
+	}
+	`, resourceName, email, groupName)
+}
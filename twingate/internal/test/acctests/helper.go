@@ -226,6 +226,10 @@ func TerraformServiceKey(name string) string {
 	return ResourceName(resource.TwingateServiceAccountKey, name)
 }
 
+func TerraformServiceKeyRotation(name string) string {
+	return ResourceName(resource.TwingateServiceAccountKeyRotation, name)
+}
+
 func TerraformUser(name string) string {
 	return ResourceName(resource.TwingateUser, name)
 }
@@ -1004,6 +1008,27 @@ func CheckTwingateUserDestroy(s *terraform.State) error {
 	return nil
 }
 
+func CheckTwingateUsersBulkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != resource.TwingateUsersBulk {
+			continue
+		}
+
+		for key, userID := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, attr.Users+".") || !strings.HasSuffix(key, "."+attr.ID) || userID == "" {
+				continue
+			}
+
+			user, _ := providerClient.ReadUser(context.Background(), userID)
+			if user != nil {
+				return fmt.Errorf("%w with ID %s", ErrResourceStillPresent, userID)
+			}
+		}
+	}
+
+	return nil
+}
+
 func CheckTwingateConnectorTokensInvalidated(s *terraform.State) error {
 	for _, res := range s.RootModule().Resources {
 		if res.Type != resource.TwingateConnectorTokens {
@@ -10,6 +10,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
@@ -49,6 +50,59 @@ func terraformResourceTwingateConnectorTokens(terraformResourceName, remoteNetwo
 	`, terraformResourceTwingateConnector(terraformResourceName, terraformResourceName, remoteNetworkName), terraformResourceName, terraformResourceName)
 }
 
+func TestAccRemoteConnectorTokensWithRotation(t *testing.T) {
+	t.Parallel()
+
+	terraformResourceName := test.TerraformRandName("test_t3")
+	theResource := acctests.TerraformConnectorTokens(terraformResourceName)
+	remoteNetworkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateConnectorTokensInvalidated,
+		Steps: []sdk.TestStep{
+			{
+				Config: terraformResourceTwingateConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, "30d"),
+				Check: acctests.ComposeTestCheckFunc(
+					checkTwingateConnectorTokensSet(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.RotationInterval, "30d"),
+				),
+			},
+			{
+				// tokens are not due for rotation yet
+				Config:   terraformResourceTwingateConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, "30d"),
+				PlanOnly: true,
+			},
+			{
+				// changing the interval is applied in place
+				Config: terraformResourceTwingateConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, "60d"),
+				ConfigPlanChecks: sdk.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(theResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: acctests.ComposeTestCheckFunc(
+					checkTwingateConnectorTokensSet(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.RotationInterval, "60d"),
+				),
+			},
+		},
+	})
+}
+
+func terraformResourceTwingateConnectorTokensWithRotation(terraformResourceName, remoteNetworkName, rotationInterval string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "twingate_connector_tokens" "%s" {
+	  connector_id = twingate_connector.%s.id
+	  rotation_interval = "%s"
+	  rotate_on_revoked = true
+	}
+	`, terraformResourceTwingateConnector(terraformResourceName, terraformResourceName, remoteNetworkName), terraformResourceName, terraformResourceName, rotationInterval)
+}
+
 func checkTwingateConnectorTokensSet(connectorNameTokens string) sdk.TestCheckFunc {
 	return func(s *terraform.State) error {
 		connectorTokens, ok := s.RootModule().Resources[connectorNameTokens]
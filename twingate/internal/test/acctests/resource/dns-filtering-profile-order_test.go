@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/resource"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccTwingateDNSFilteringProfileOrder(t *testing.T) {
+	t.Parallel()
+
+	testName := "t" + acctest.RandString(6)
+	theOrder := acctests.ResourceName(resource.TwingateDNSFilteringProfileOrder, testName)
+	first := acctests.TerraformDNSFilteringProfile(testName + "_1")
+	second := acctests.TerraformDNSFilteringProfile(testName + "_2")
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateDNSProfileDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: testTwingateDNSFilteringProfileOrder(testName, []string{first, second}),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theOrder, attr.Priorities+".%", "2"),
+					checkDNSFilteringProfilePriority(theOrder, first, "1001"),
+					checkDNSFilteringProfilePriority(theOrder, second, "1000"),
+				),
+			},
+			{
+				Config: testTwingateDNSFilteringProfileOrder(testName, []string{second, first}),
+				Check: acctests.ComposeTestCheckFunc(
+					checkDNSFilteringProfilePriority(theOrder, first, "1000"),
+					checkDNSFilteringProfilePriority(theOrder, second, "1001"),
+				),
+			},
+		},
+	})
+}
+
+func testTwingateDNSFilteringProfileOrder(testName string, order []string) string {
+	profileIDs := make([]string, 0, len(order))
+	for _, profile := range order {
+		profileIDs = append(profileIDs, profile+".id")
+	}
+
+	return fmt.Sprintf(`
+	resource "twingate_dns_filtering_profile" "%[1]s_1" {
+	  name = "%[2]s"
+	  priority = 1001
+
+	  lifecycle {
+	    ignore_changes = [priority]
+	  }
+	}
+
+	resource "twingate_dns_filtering_profile" "%[1]s_2" {
+	  name = "%[3]s"
+	  priority = 1000
+
+	  lifecycle {
+	    ignore_changes = [priority]
+	  }
+	}
+
+	resource "twingate_dns_filtering_profile_order" "%[1]s" {
+	  profile_ids = [%[4]s]
+	  base_priority = 1000
+	}
+	`, testName, test.RandomName(testName), test.RandomName(testName), strings.Join(profileIDs, ", "))
+}
+
+func checkDNSFilteringProfilePriority(orderResource, profileResource, expected string) sdk.TestCheckFunc {
+	return func(state *terraform.State) error {
+		profile, ok := state.RootModule().Resources[profileResource]
+		if !ok {
+			return fmt.Errorf("%w: %s", acctests.ErrResourceNotFound, profileResource)
+		}
+
+		return sdk.TestCheckResourceAttr(orderResource, attr.Path(attr.Priorities, profile.Primary.ID), expected)(state)
+	}
+}
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
@@ -249,3 +250,47 @@ func terraformResourceRemoteNetworkExitNode(terraformResourceName, name, network
 	}
 	`, terraformResourceName, name, networkType)
 }
+
+func TestAccTwingateRemoteNetworkDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	const terraformResourceName = "test009"
+	theResource := acctests.TerraformRemoteNetwork(terraformResourceName)
+	networkName := test.RandomName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateRemoteNetworkDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createRemoteNetworkWithDeletionProtection(terraformResourceName, networkName, true),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.DeletionProtection, "true"),
+				),
+			},
+			{
+				Config:      createRemoteNetworkWithDeletionProtection(terraformResourceName, networkName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion protection enabled"),
+			},
+			{
+				Config: createRemoteNetworkWithDeletionProtection(terraformResourceName, networkName, false),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(theResource),
+					sdk.TestCheckResourceAttr(theResource, attr.DeletionProtection, "false"),
+				),
+			},
+		},
+	})
+}
+
+func createRemoteNetworkWithDeletionProtection(terraformResourceName, name string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "%s" {
+	  name = "%s"
+	  deletion_protection = %v
+	}
+	`, terraformResourceName, name, deletionProtection)
+}
@@ -310,6 +310,40 @@ func createResourceWithInvalidGroupId(networkName, resourceName string) string {
 	`, networkName, resourceName)
 }
 
+func TestAccTwingateResourceOnExitNetwork(t *testing.T) {
+	t.Parallel()
+
+	resourceName := test.RandomResourceName()
+	networkName := test.RandomResourceName()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateResourceDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config:      createResourceOnExitNetwork(networkName, resourceName),
+				ExpectError: regexp.MustCompile(resource.ErrResourceOnExitNetwork.Error()),
+			},
+		},
+	})
+}
+
+func createResourceOnExitNetwork(networkName, resourceName string) string {
+	return fmt.Sprintf(`
+	resource "twingate_remote_network" "test_exit" {
+	  name = "%s"
+	  type = "EXIT"
+	}
+
+	resource "twingate_resource" "test_exit" {
+	  name = "%s"
+	  address = "acc-test.com"
+	  remote_network_id = twingate_remote_network.test_exit.id
+	}
+	`, networkName, resourceName)
+}
+
 func TestAccTwingateResourceWithTcpDenyAllPolicy(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,99 @@
+package resource
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func createServiceKeyRotation(terraformResourceName, serviceAccountName, rotationPeriod, overlap string) string {
+	return fmt.Sprintf(`
+	%s
+
+	resource "twingate_service_account_key_rotation" "%s" {
+	  service_account_id = twingate_service_account.%s.id
+	  name = "%s"
+	  rotation_period = "%s"
+	  overlap = "%s"
+	}
+	`, createServiceAccount(terraformResourceName, serviceAccountName), terraformResourceName, terraformResourceName, serviceAccountName, rotationPeriod, overlap)
+}
+
+func TestAccTwingateServiceKeyRotationCreate(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountName := test.RandomName()
+	terraformResourceName := test.TerraformRandName("test_rotation")
+	rotation := acctests.TerraformServiceKeyRotation(terraformResourceName)
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateServiceAccountDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createServiceKeyRotation(terraformResourceName, serviceAccountName, "30d", "1d"),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttrWith(rotation, attr.CurrentKeyID, nonEmptyValue),
+					sdk.TestCheckResourceAttrWith(rotation, attr.CurrentToken, nonEmptyValue),
+					sdk.TestCheckNoResourceAttr(rotation, attr.PreviousKeyID),
+					sdk.TestCheckNoResourceAttr(rotation, attr.PreviousToken),
+					sdk.TestCheckResourceAttr(rotation, attr.Generations, "2"),
+					sdk.TestCheckResourceAttr(rotation, attr.Len(attr.Keys), "1"),
+				),
+			},
+			{
+				// no rotation is due, so the plan must be empty
+				Config:   createServiceKeyRotation(terraformResourceName, serviceAccountName, "30d", "1d"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccTwingateServiceKeyRotationInvalidOverlap(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountName := test.RandomName()
+	terraformResourceName := test.TerraformRandName("test_rotation")
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		Steps: []sdk.TestStep{
+			{
+				Config:      createServiceKeyRotation(terraformResourceName, serviceAccountName, "1d", "2d"),
+				ExpectError: regexp.MustCompile("overlap must be shorter than rotation_period"),
+			},
+		},
+	})
+}
+
+func TestAccTwingateServiceKeyRotationShortPeriod(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountName := test.RandomName()
+	terraformResourceName := test.TerraformRandName("test_rotation")
+	rotation := acctests.TerraformServiceKeyRotation(terraformResourceName)
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateServiceAccountDestroy,
+		Steps: []sdk.TestStep{
+			{
+				// the minimum rotation period needs an overlap shorter than 1 hour
+				Config: createServiceKeyRotation(terraformResourceName, serviceAccountName, "1h", "30m"),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(rotation, attr.RotationPeriod, "1h"),
+					sdk.TestCheckResourceAttr(rotation, attr.Overlap, "30m"),
+				),
+			},
+		},
+	})
+}
@@ -3,7 +3,9 @@ package resource
 import (
 	"errors"
 	"fmt"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
@@ -13,6 +15,7 @@ import (
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
 	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 var ErrEmptyValue = errors.New("empty value")
@@ -417,3 +420,47 @@ func createServiceKeyV1(terraformServiceAccountName, serviceAccountName, terrafo
 	}
 	`, terraformServiceAccountName, serviceAccountName, terraformServiceAccountNameV2, serviceAccountNameV2, terraformServiceAccountKeyName, serviceAccount)
 }
+
+func TestAccTwingateEphemeralServiceKey(t *testing.T) {
+	t.Parallel()
+
+	serviceAccountName := test.RandomName()
+	terraformResourceName := test.TerraformRandName("test_key")
+	serviceAccount := acctests.TerraformServiceAccount(terraformResourceName)
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck: func() {
+			acctests.PreCheck(t)
+
+			// Skip if running with OpenTofu
+			if strings.Contains(os.Getenv("TF_ACC_PROVIDER_HOST"), "opentofu.org") {
+				t.Skip("Ephemeral resources not supported in OpenTofu")
+			}
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources require Terraform 1.10+
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: acctests.CheckTwingateServiceAccountDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: createEphemeralServiceKey(terraformResourceName, serviceAccountName),
+				Check: acctests.ComposeTestCheckFunc(
+					acctests.CheckTwingateResourceExists(serviceAccount),
+				),
+			},
+		},
+	})
+}
+
+func createEphemeralServiceKey(terraformResourceName, serviceAccountName string) string {
+	return fmt.Sprintf(`
+	%s
+
+	ephemeral "twingate_service_account_key" "%s" {
+	  service_account_id = twingate_service_account.%s.id
+	  expiration_time = 1
+	}
+	`, createServiceAccount(terraformResourceName, serviceAccountName), terraformResourceName, terraformResourceName)
+}
@@ -0,0 +1,83 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/resource"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/acctests"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	sdk "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTwingateUsersBulk(t *testing.T) {
+	t.Parallel()
+
+	testName := "t" + acctest.RandString(6)
+	theResource := acctests.ResourceName(resource.TwingateUsersBulk, testName)
+	first := test.RandomEmail()
+	second := test.RandomEmail()
+
+	sdk.Test(t, sdk.TestCase{
+		ProtoV6ProviderFactories: acctests.ProviderFactories,
+		PreCheck:                 func() { acctests.PreCheck(t) },
+		CheckDestroy:             acctests.CheckTwingateUsersBulkDestroy,
+		Steps: []sdk.TestStep{
+			{
+				Config: testTwingateUsersBulk(testName, model.UserRoleMember, first, second),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.Len(attr.Users), "2"),
+					sdk.TestCheckResourceAttrSet(theResource, attr.Path(attr.Users, attr.ID)),
+					sdk.TestCheckResourceAttr(theResource, attr.Path(attr.Users, attr.Role), model.UserRoleMember),
+					sdk.TestCheckResourceAttr(theResource, attr.Len(attr.Users, attr.GroupIDs), "1"),
+				),
+			},
+			{
+				Config: testTwingateUsersBulk(testName, model.UserRoleSupport, first),
+				Check: acctests.ComposeTestCheckFunc(
+					sdk.TestCheckResourceAttr(theResource, attr.Len(attr.Users), "1"),
+					sdk.TestCheckResourceAttr(theResource, attr.Path(attr.Users, attr.Email), first),
+					sdk.TestCheckResourceAttr(theResource, attr.Path(attr.Users, attr.Role), model.UserRoleSupport),
+				),
+			},
+			{
+				ImportState:             true,
+				ImportStateId:           first,
+				ResourceName:            theResource,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{attr.Path(attr.Users, attr.GroupIDs)},
+			},
+		},
+	})
+}
+
+func testTwingateUsersBulk(testName, role string, emails ...string) string {
+	users := make([]string, 0, len(emails))
+	for _, email := range emails {
+		users = append(users, fmt.Sprintf(`{
+	      email = "%s"
+	      role = "%s"
+	      group_ids = [twingate_group.%s.id]
+	    }`, email, role, testName))
+	}
+
+	return fmt.Sprintf(`
+	resource "twingate_group" "%[1]s" {
+	  name = "%[2]s"
+
+	  lifecycle {
+	    ignore_changes = [user_ids]
+	  }
+	}
+
+	resource "twingate_users_bulk" "%[1]s" {
+	  users = [
+	    %[3]s
+	  ]
+	}
+	`, testName, test.RandomGroupName(), strings.Join(users, ",\n"))
+}
@@ -0,0 +1,340 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvMode sets the mode of the cassettes: `record` runs the tests against the real API
+	// configured with the TWINGATE_* env vars and saves the sessions, `replay` is the default.
+	EnvMode = "TWINGATE_CASSETTE_MODE"
+
+	ModeRecord = "record"
+	ModeReplay = "replay"
+
+	cassettesDir = "testdata/cassettes"
+	redacted     = "REDACTED"
+
+	yamlIndent      = 2
+	filePermissions = 0o600
+	dirPermissions  = 0o750
+)
+
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// the keys of the tokens that are redacted from the recorded requests and responses.
+//
+//nolint:gochecknoglobals
+var sensitiveKeys = map[string]bool{
+	"token":         true,
+	"accesstoken":   true,
+	"access_token":  true,
+	"refreshtoken":  true,
+	"refresh_token": true,
+	"apikey":        true,
+	"api_key":       true,
+}
+
+// Interaction is a recorded request with its response. The request is identified by the GraphQL operation
+// name, or the method and path for the REST API, and by its variables.
+type Interaction struct {
+	Operation string         `yaml:"operation"`
+	Variables map[string]any `yaml:"variables,omitempty"`
+	Status    int            `yaml:"status"`
+	Response  string         `yaml:"response"`
+}
+
+type Cassette struct {
+	Interactions []*Interaction `yaml:"interactions"`
+}
+
+// Recorder is a transport recording the sessions of a client to a cassette file, or replaying them offline.
+type Recorder struct {
+	t         *testing.T
+	mode      string
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns the recorder of the testdata/cassettes/<name>.yaml cassette. In replay mode the cassette
+// is loaded, in record mode it is saved when the test succeeds.
+func New(t *testing.T, name string) *Recorder {
+	t.Helper()
+
+	recorder := &Recorder{
+		t:    t,
+		mode: getMode(),
+		path: filepath.Join(cassettesDir, name+".yaml"),
+	}
+
+	if recorder.mode == ModeRecord {
+		t.Cleanup(recorder.save)
+
+		return recorder
+	}
+
+	data, err := os.ReadFile(recorder.path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+
+	if err := yaml.Unmarshal(data, &recorder.cassette); err != nil {
+		t.Fatalf("failed to parse cassette %s: %v", recorder.path, err)
+	}
+
+	recorder.used = make([]bool, len(recorder.cassette.Interactions))
+
+	return recorder
+}
+
+// NewClient returns a client whose requests go through the recorder of the cassette.
+// In record mode the client uses the API configured with the TWINGATE_* env vars.
+func NewClient(t *testing.T, name string) *client.Client {
+	t.Helper()
+
+	recorder := New(t, name)
+
+	var apiClient *client.Client
+
+	if recorder.mode == ModeRecord {
+		apiClient, _ = test.TwingateClient()
+	} else {
+		apiClient = client.NewClient(t.Context(), "https://test.twindev.com", "xxxx",
+			client.DefaultOperationOptions(time.Second, 0), nil, client.DefaultAgent, "test", client.CacheOptions{})
+	}
+
+	recorder.Wrap(apiClient)
+
+	return apiClient
+}
+
+// Wrap routes the requests of the client through the recorder.
+func (r *Recorder) Wrap(apiClient *client.Client) {
+	r.transport = apiClient.HTTPClient.Transport
+	apiClient.HTTPClient.Transport = r
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, variables := parseRequest(req, body)
+
+	if r.mode == ModeRecord {
+		return r.record(req, operation, variables)
+	}
+
+	return r.replay(req, operation, variables)
+}
+
+func (r *Recorder) record(req *http.Request, operation string, variables map[string]any) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Operation: operation,
+		Variables: variables,
+		Status:    resp.StatusCode,
+		Response:  sanitizeResponse(body),
+	})
+
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching the request.
+func (r *Recorder) replay(req *http.Request, operation string, variables map[string]any) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Operation != operation || !equalVariables(interaction.Variables, variables) {
+			continue
+		}
+
+		r.used[i] = true
+
+		return &http.Response{
+			StatusCode: interaction.Status,
+			Status:     fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(interaction.Response)),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %v in %s", ErrNoInteraction, operation, variables, r.path)
+}
+
+func (r *Recorder) save() {
+	if r.t.Failed() {
+		return
+	}
+
+	var data bytes.Buffer
+
+	encoder := yaml.NewEncoder(&data)
+	encoder.SetIndent(yamlIndent)
+
+	if err := encoder.Encode(&r.cassette); err != nil {
+		r.t.Errorf("failed to marshal cassette: %v", err)
+
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), dirPermissions); err != nil {
+		r.t.Errorf("failed to create cassettes dir: %v", err)
+
+		return
+	}
+
+	if err := os.WriteFile(r.path, data.Bytes(), filePermissions); err != nil {
+		r.t.Errorf("failed to write cassette: %v", err)
+	}
+}
+
+func getMode() string {
+	if os.Getenv(EnvMode) == ModeRecord {
+		return ModeRecord
+	}
+
+	return ModeReplay
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// parseRequest returns the operation and the sanitized variables of the request.
+func parseRequest(req *http.Request, body []byte) (string, map[string]any) {
+	var graphqlRequest struct {
+		OperationName string         `json:"operationName"`
+		Variables     map[string]any `json:"variables"`
+	}
+
+	if err := json.Unmarshal(body, &graphqlRequest); err == nil && graphqlRequest.OperationName != "" {
+		return graphqlRequest.OperationName, sanitizeVariables(graphqlRequest.Variables)
+	}
+
+	var variables map[string]any
+
+	_ = json.Unmarshal(body, &variables)
+
+	return req.Method + " " + req.URL.Path, sanitizeVariables(variables)
+}
+
+func sanitizeVariables(variables map[string]any) map[string]any {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	sanitized, _ := sanitize(variables).(map[string]any)
+
+	return sanitized
+}
+
+// sanitizeResponse returns the indented response body with the tokens redacted.
+func sanitizeResponse(body []byte) string {
+	var response any
+	if err := json.Unmarshal(body, &response); err != nil {
+		return string(body)
+	}
+
+	data, err := json.MarshalIndent(sanitize(response), "", "  ")
+	if err != nil {
+		return string(body)
+	}
+
+	return string(data)
+}
+
+func sanitize(value any) any {
+	switch val := value.(type) {
+	case map[string]any:
+		for key, item := range val {
+			if sensitiveKeys[strings.ToLower(key)] && item != nil {
+				val[key] = redacted
+
+				continue
+			}
+
+			val[key] = sanitize(item)
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = sanitize(item)
+		}
+	}
+
+	return value
+}
+
+// equalVariables compares the variables after a JSON round trip, as the numbers decoded from YAML and JSON differ.
+func equalVariables(recorded, actual map[string]any) bool {
+	return reflect.DeepEqual(normalize(recorded), normalize(actual))
+}
+
+func normalize(variables map[string]any) any {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(variables)
+	if err != nil {
+		return variables
+	}
+
+	var normalized any
+
+	_ = json.Unmarshal(data, &normalized)
+
+	return normalized
+}
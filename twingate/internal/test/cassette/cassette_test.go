@@ -0,0 +1,104 @@
+package cassette
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const generateTokensJSON = `{
+  "data": {
+    "connectorGenerateTokens": {
+      "connectorTokens": {
+        "accessToken": "secret-access-token",
+        "refreshToken": "secret-refresh-token"
+      },
+      "ok": true,
+      "error": null
+    }
+  }
+}`
+
+func newServerClient(t *testing.T, serverURL string) *client.Client {
+	t.Helper()
+
+	return client.NewClient(t.Context(), serverURL, "secret-api-key",
+		client.DefaultOperationOptions(time.Second, 0), nil, client.DefaultAgent, "test", client.CacheOptions{})
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	t.Run("record", func(t *testing.T) {
+		t.Setenv(EnvMode, ModeRecord)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(generateTokensJSON))
+		}))
+		defer server.Close()
+
+		apiClient := newServerClient(t, server.URL)
+		New(t, "tokens").Wrap(apiClient)
+
+		tokens, err := apiClient.GenerateConnectorTokens(t.Context(), "connector-1")
+		require.NoError(t, err)
+		assert.Equal(t, "secret-access-token", tokens.AccessToken)
+	})
+
+	content, err := os.ReadFile(filepath.Join(cassettesDir, "tokens.yaml"))
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "operation: generateConnectorTokens")
+	assert.Contains(t, string(content), "connectorId: connector-1")
+	assert.NotContains(t, string(content), "secret")
+
+	t.Run("replay", func(t *testing.T) {
+		apiClient := NewClient(t, "tokens")
+
+		tokens, err := apiClient.GenerateConnectorTokens(t.Context(), "connector-1")
+		require.NoError(t, err)
+		assert.Equal(t, redacted, tokens.AccessToken)
+		assert.Equal(t, redacted, tokens.RefreshToken)
+	})
+
+	t.Run("replay unknown variables", func(t *testing.T) {
+		apiClient := NewClient(t, "tokens")
+
+		_, err := apiClient.GenerateConnectorTokens(t.Context(), "connector-2")
+		assert.ErrorIs(t, err, ErrNoInteraction)
+	})
+
+	t.Run("replay consumes interactions", func(t *testing.T) {
+		apiClient := NewClient(t, "tokens")
+
+		_, err := apiClient.GenerateConnectorTokens(t.Context(), "connector-1")
+		require.NoError(t, err)
+
+		_, err = apiClient.GenerateConnectorTokens(t.Context(), "connector-1")
+		assert.ErrorIs(t, err, ErrNoInteraction)
+	})
+}
+
+func TestReplayRESTRequest(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	require.NoError(t, os.MkdirAll(cassettesDir, dirPermissions))
+	require.NoError(t, os.WriteFile(filepath.Join(cassettesDir, "verify.yaml"), []byte(`interactions:
+  - operation: POST /api/v4/connector/validate_tokens
+    variables:
+      refresh_token: REDACTED
+    status: 200
+    response: '{}'
+`), filePermissions))
+
+	apiClient := NewClient(t, "verify")
+
+	assert.NoError(t, apiClient.VerifyConnectorTokens(t.Context(), "refresh", "access"))
+}
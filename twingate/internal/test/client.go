@@ -32,8 +32,8 @@ func TwingateClient() (*client.Client, error) {
 	return client.NewClient(context.Background(),
 			fmt.Sprintf("https://%s.%s", os.Getenv(twingate.EnvNetwork), os.Getenv(twingate.EnvURL)),
 			os.Getenv(twingate.EnvAPIToken),
-			getHTTPTimeout(twingate.EnvHTTPTimeout, testTimeoutDuration),
-			testHTTPRetry,
+			client.DefaultOperationOptions(getHTTPTimeout(twingate.EnvHTTPTimeout, testTimeoutDuration), testHTTPRetry),
+			nil,
 			client.DefaultAgent,
 			"test",
 			client.CacheOptions{}),
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const apiTokenJSON = `{
+  "data": {
+    "remoteNetworks": {
+      "pageInfo": {
+        "endCursor": "cursor-001",
+        "hasNextPage": false
+      }
+    }
+  }
+}`
+
+func TestClientValidateAPIToken(t *testing.T) {
+	cl := newHTTPMockClient()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", cl.GraphqlServerURL,
+		httpmock.NewStringResponder(http.StatusOK, apiTokenJSON))
+
+	err := cl.ValidateAPIToken(context.Background())
+
+	assert.NoError(t, err)
+	// validating the token runs a single read query
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClientValidateAPITokenError(t *testing.T) {
+	cases := []struct {
+		name      string
+		responder httpmock.Responder
+		expected  error
+	}{
+		{
+			name:      "invalid token",
+			responder: httpmock.NewStringResponder(http.StatusUnauthorized, `unauthorized`),
+			expected:  client.ErrAuthExpired,
+		},
+		{
+			name:      "unknown network",
+			responder: httpmock.NewStringResponder(http.StatusNotFound, `not found`),
+			expected:  client.ErrEndpointNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cl := newHTTPMockClient()
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", cl.GraphqlServerURL, c.responder)
+
+			err := cl.ValidateAPIToken(context.Background())
+
+			assert.ErrorIs(t, err, c.expected)
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,7 +18,7 @@ import (
 func newHTTPMockClient() *client.Client {
 
 	c := client.NewClient(context.Background(), "https://test.twindev.com", "xxxx",
-		time.Duration(1)*time.Second, 2, client.DefaultAgent, "test", client.CacheOptions{})
+		client.DefaultOperationOptions(time.Second, 2), nil, client.DefaultAgent, "test", client.CacheOptions{})
 	httpmock.ActivateNonDefault(c.HTTPClient)
 
 	return c
@@ -35,6 +39,37 @@ func MultipleResponders(responses ...httpmock.Responder) httpmock.Responder {
 	}
 }
 
+// OperationResponders dispatches the requests by the last part of their GraphQL operation name,
+// e.g. readGroupsAfter for readFullGroups_readGroupsAfter. It keeps the tests independent of the order
+// of concurrent requests, like the prefetched pages.
+func OperationResponders(responders map[string]httpmock.Responder) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		var payload struct {
+			OperationName string `json:"operationName"`
+		}
+
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+
+		operations := strings.Split(payload.OperationName, "_")
+
+		responder, ok := responders[operations[len(operations)-1]]
+		if !ok {
+			return nil, fmt.Errorf("no responder for operation %s", payload.OperationName)
+		}
+
+		return responder(req)
+	}
+}
+
 func graphqlErr(client *client.Client, message string, err error) string {
 	return fmt.Sprintf(`%s: Post "%s": %v`, message, client.GraphqlServerURL, err)
 }
@@ -233,6 +233,62 @@ func TestClientDNSProfileUpdateWithEmptyID(t *testing.T) {
 	})
 }
 
+func TestClientDNSProfileUpdatePriorityOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Update DNS Profile Priority Ok", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "dnsFilteringProfileUpdate": {
+		      "ok": true,
+		      "error": null
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse))
+
+		err := c.UpdateDNSFilteringProfilePriority(context.Background(), "id", 2.5)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestClientDNSProfileUpdatePriorityError(t *testing.T) {
+	t.Run("Test Twingate Resource : Update DNS Profile Priority Error", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "dnsFilteringProfileUpdate": {
+		      "ok": false,
+		      "error": "error_1"
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(200, jsonResponse))
+
+		const profileId = "g1"
+		err := c.UpdateDNSFilteringProfilePriority(context.Background(), profileId, 1)
+
+		assert.EqualError(t, err, fmt.Sprintf("failed to update DNS filtering profile with id %s: error_1", profileId))
+	})
+}
+
+func TestClientDNSProfileUpdatePriorityWithEmptyID(t *testing.T) {
+	t.Run("Test Twingate Resource : Update DNS Profile Priority With Empty ID", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+
+		err := c.UpdateDNSFilteringProfilePriority(context.Background(), "", 1)
+
+		assert.EqualError(t, err, "failed to update DNS filtering profile: id is empty")
+	})
+}
+
 func TestClientDNSProfileReadOk(t *testing.T) {
 	t.Run("Test Twingate Resource : Read DNS Profile Ok", func(t *testing.T) {
 		expected := &model.DNSFilteringProfile{
@@ -1,9 +1,14 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,3 +17,137 @@ func TestUnwrapError(t *testing.T) {
 
 	assert.Equal(t, errBadRequest, err.Unwrap())
 }
+
+func TestClientErrorClasses(t *testing.T) {
+	cases := []struct {
+		name      string
+		responder httpmock.Responder
+		expected  error
+	}{
+		{
+			name:      "http status",
+			responder: httpmock.NewStringResponder(http.StatusForbidden, `forbidden`),
+			expected:  client.ErrPermissionDenied,
+		},
+		{
+			name: "graphql error code",
+			responder: httpmock.NewStringResponder(http.StatusOK, `{
+			  "data": null,
+			  "errors": [{"message": "user does not exist", "extensions": {"code": "NOT_FOUND"}}]
+			}`),
+			expected: client.ErrNotFound,
+		},
+		{
+			name:      "empty result",
+			responder: httpmock.NewStringResponder(http.StatusOK, `{"data": {"user": null}}`),
+			expected:  client.ErrNotFound,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cl := newHTTPMockClient()
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", cl.GraphqlServerURL, c.responder)
+
+			_, err := cl.ReadUser(context.Background(), "user-id")
+
+			assert.ErrorIs(t, err, c.expected)
+		})
+	}
+}
+
+func TestClientMutationErrorIsValidation(t *testing.T) {
+	jsonResponse := `{
+	  "data": {
+	    "userDelete": {
+	      "ok": false,
+	      "error": "user can't be deleted"
+	    }
+	  }
+	}`
+
+	c := newHTTPMockClient()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+		httpmock.NewStringResponder(http.StatusOK, jsonResponse),
+	)
+
+	err := c.DeleteUser(context.Background(), "user-id")
+
+	assert.ErrorIs(t, err, client.ErrValidation)
+	assert.EqualError(t, err, "failed to delete user with id user-id: user can't be deleted")
+}
+
+func TestClientMutationNotFoundMessageIsValidation(t *testing.T) {
+	const message = "Remote network does not exist"
+
+	t.Run("create", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"data": {"groupCreate": {"ok": false, "error": %q}}}`, message)),
+		)
+
+		_, err := c.CreateGroup(context.Background(), &model.Group{Name: "test"})
+
+		assert.ErrorIs(t, err, client.ErrValidation)
+		assert.NotErrorIs(t, err, client.ErrNotFound)
+		assert.ErrorContains(t, err, message)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"data": {"groupUpdate": {"ok": false, "error": %q}}}`, message)),
+		)
+
+		_, err := c.UpdateGroup(context.Background(), &model.Group{ID: "group-id", Name: "test"})
+
+		assert.ErrorIs(t, err, client.ErrValidation)
+		assert.NotErrorIs(t, err, client.ErrNotFound)
+		assert.ErrorContains(t, err, message)
+	})
+}
+
+func TestClientEndpointNotFoundKeepsState(t *testing.T) {
+	c := newHTTPMockClient()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+		httpmock.NewStringResponder(http.StatusNotFound, `not found`),
+	)
+
+	_, err := c.ReadUser(context.Background(), "user-id")
+
+	// a missing endpoint must not remove the user from the state
+	assert.ErrorIs(t, err, client.ErrEndpointNotFound)
+	assert.NotErrorIs(t, err, client.ErrNotFound)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestClientMutationErrorClasses(t *testing.T) {
+	cases := []struct {
+		message  string
+		expected error
+	}{
+		{message: "NOT_FOUND: user", expected: client.ErrNotFound},
+		{message: "PERMISSION_DENIED: user", expected: client.ErrPermissionDenied},
+		{message: "CONFLICT: user is being updated", expected: client.ErrConflict},
+	}
+
+	for _, c := range cases {
+		t.Run(c.message, func(t *testing.T) {
+			cl := newHTTPMockClient()
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", cl.GraphqlServerURL,
+				httpmock.NewStringResponder(http.StatusOK, fmt.Sprintf(`{"data": {"userDelete": {"ok": false, "error": %q}}}`, c.message)),
+			)
+
+			err := cl.DeleteUser(context.Background(), "user-id")
+
+			assert.ErrorIs(t, err, c.expected)
+			assert.NotErrorIs(t, err, client.ErrValidation)
+		})
+	}
+}
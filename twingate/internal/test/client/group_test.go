@@ -1155,6 +1155,115 @@ func TestClientDeleteGroupUsersEmptyResponse(t *testing.T) {
 	})
 }
 
+func TestClientAddGroupUsers(t *testing.T) {
+	t.Run("Test Twingate Resource : Add Group Users", func(t *testing.T) {
+		jsonResponse := `{
+          "data": {
+            "groupUpdate": {
+              "ok": true,
+              "error": null,
+              "entity": {
+                "id": "group-1",
+                "name": "group-1"
+              }
+            }
+          }
+        }`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse),
+		)
+
+		err := c.AddGroupUsers(context.Background(), "group-1", []string{"user-1"})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestClientAddGroupUsersEmptyUsers(t *testing.T) {
+	t.Run("Test Twingate Resource : Add Group Users - Empty Users", func(t *testing.T) {
+		c := newHTTPMockClient()
+
+		err := c.AddGroupUsers(context.Background(), "group-1", nil)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestClientAddGroupUsersEmptyID(t *testing.T) {
+	t.Run("Test Twingate Resource : Add Group Users - Empty ID", func(t *testing.T) {
+		c := newHTTPMockClient()
+
+		err := c.AddGroupUsers(context.Background(), "", []string{"user-1"})
+
+		assert.EqualError(t, err, "failed to update group: id is empty")
+	})
+}
+
+func TestClientAddGroupUsersRequestError(t *testing.T) {
+	t.Run("Test Twingate Resource : Add Group Users - Request Error", func(t *testing.T) {
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewErrorResponder(errBadRequest),
+		)
+
+		err := c.AddGroupUsers(context.Background(), "group-1", []string{"user-1"})
+
+		assert.EqualError(t, err, graphqlErr(c, "failed to update group with id group-1", errBadRequest))
+	})
+}
+
+func TestClientAddGroupUsersResponseError(t *testing.T) {
+	t.Run("Test Twingate Resource : Add Group Users - Response Error", func(t *testing.T) {
+		jsonResponse := `{
+          "data": {
+            "groupUpdate": {
+              "ok": false,
+              "error": "bad error",
+              "entity": null
+            }
+          }
+        }`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse),
+		)
+
+		err := c.AddGroupUsers(context.Background(), "group-1", []string{"user-1"})
+
+		assert.EqualError(t, err, `failed to update group with id group-1: bad error`)
+	})
+}
+
+func TestClientAddGroupUsersEmptyResponse(t *testing.T) {
+	t.Run("Test Twingate Resource : Add Group Users - Empty Response", func(t *testing.T) {
+		jsonResponse := `{
+          "data": {
+            "groupUpdate": {
+              "ok": true,
+              "error": null,
+              "entity": null
+            }
+          }
+        }`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse),
+		)
+
+		err := c.AddGroupUsers(context.Background(), "group-1", []string{"user-1"})
+
+		assert.EqualError(t, err, `failed to update group with id group-1: query result is empty`)
+	})
+}
+
 func TestClientGroupsReadFullOk(t *testing.T) {
 	t.Run("Test Twingate Resource : Read Full Groups Ok", func(t *testing.T) {
 		expected := []*model.Group{
@@ -1386,13 +1495,15 @@ func TestClientGroupsReadFullWithAllUsers(t *testing.T) {
 		c := newHTTPMockClient()
 		defer httpmock.DeactivateAndReset()
 		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
-			MultipleResponders(
-				httpmock.NewStringResponder(http.StatusOK, response1),
-				httpmock.NewStringResponder(http.StatusOK, response2),
-				httpmock.NewStringResponder(http.StatusOK, response3),
-				httpmock.NewStringResponder(http.StatusOK, response4),
-				httpmock.NewStringResponder(http.StatusOK, response5),
-			))
+			OperationResponders(map[string]httpmock.Responder{
+				"readFullGroups":  httpmock.NewStringResponder(http.StatusOK, response1),
+				"readGroupsAfter": httpmock.NewStringResponder(http.StatusOK, response2),
+				"readGroupUsersAfter": MultipleResponders(
+					httpmock.NewStringResponder(http.StatusOK, response3),
+					httpmock.NewStringResponder(http.StatusOK, response4),
+					httpmock.NewStringResponder(http.StatusOK, response5),
+				),
+			}))
 
 		groups, err := c.ReadFullGroups(context.Background())
 
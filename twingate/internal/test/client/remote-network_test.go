@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
@@ -677,3 +678,110 @@ func TestClientReadRemoteNetworkWithNameOk(t *testing.T) {
 		assert.Equal(t, expected, network)
 	})
 }
+
+func TestClientReadRemoteNetworkDependenciesOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Dependencies Ok", func(t *testing.T) {
+		expected := &model.RemoteNetworkDependencies{
+			Connectors: []*model.Connector{
+				{ID: "connector1", Name: "tf-acc-connector1", NetworkID: "network1", StatusUpdatesEnabled: new(bool)},
+			},
+			Resources: []*model.Resource{
+				{ID: "resource1", Name: "tf-acc-resource1", RemoteNetworkID: "network1"},
+			},
+			SSHResources: []*model.SSHResource{
+				{ID: "ssh1", Name: "tf-acc-ssh1", RemoteNetworkID: "network1"},
+			},
+			KubernetesResources: []*model.KubernetesResource{
+				{ID: "k8s1", Name: "tf-acc-k8s1", RemoteNetworkID: "network1"},
+			},
+			Gateways: []*model.Gateway{
+				{ID: "gateway1", Address: "10.0.0.1:8443", RemoteNetworkID: "network1", X509CAID: "ca1"},
+			},
+		}
+
+		connectorsResponse := `{
+		  "data": {
+		    "connectors": {
+		      "pageInfo": {"endCursor": "", "hasNextPage": false},
+		      "edges": [
+		        {"node": {"id": "connector1", "name": "tf-acc-connector1", "remoteNetwork": {"id": "network1"}}},
+		        {"node": {"id": "connector2", "name": "tf-acc-connector2", "remoteNetwork": {"id": "network2"}}}
+		      ]
+		    }
+		  }
+		}`
+
+		resourcesResponse := `{
+		  "data": {
+		    "resources": {
+		      "pageInfo": {"endCursor": "", "hasNextPage": false},
+		      "edges": [
+		        {"node": {"__typename": "NetworkResource", "id": "resource1", "name": "tf-acc-resource1"}},
+		        {"node": {"__typename": "SSHResource", "id": "ssh1", "name": "tf-acc-ssh1"}},
+		        {"node": {"__typename": "KubernetesResource", "id": "k8s1", "name": "tf-acc-k8s1"}}
+		      ]
+		    }
+		  }
+		}`
+
+		gatewaysResponse := `{
+		  "data": {
+		    "gateways": {
+		      "pageInfo": {"endCursor": "", "hasNextPage": false},
+		      "edges": [
+		        {"node": {"id": "gateway1", "address": "10.0.0.1:8443", "remoteNetwork": {"id": "network1"}, "x509CA": {"id": "ca1"}}},
+		        {"node": {"id": "gateway2", "address": "10.0.0.2:8443", "remoteNetwork": {"id": "network2"}, "x509CA": {"id": "ca1"}}}
+		      ]
+		    }
+		  }
+		}`
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.ResponderFromMultipleResponses(
+				[]*http.Response{
+					httpmock.NewStringResponse(200, connectorsResponse),
+					httpmock.NewStringResponse(200, resourcesResponse),
+					httpmock.NewStringResponse(200, gatewaysResponse),
+				}),
+		)
+
+		dependencies, err := client.ReadRemoteNetworkDependencies(context.Background(), "network1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, dependencies)
+		assert.False(t, dependencies.IsEmpty())
+	})
+}
+
+func TestClientReadRemoteNetworkDependenciesEmpty(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Dependencies Empty", func(t *testing.T) {
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			httpmock.ResponderFromMultipleResponses(
+				[]*http.Response{
+					httpmock.NewStringResponse(200, `{"data": {"connectors": {"edges": []}}}`),
+					httpmock.NewStringResponse(200, `{"data": {"resources": {"edges": []}}}`),
+					httpmock.NewStringResponse(200, `{"data": {"gateways": {"edges": []}}}`),
+				}),
+		)
+
+		dependencies, err := client.ReadRemoteNetworkDependencies(context.Background(), "network1")
+
+		assert.NoError(t, err)
+		assert.True(t, dependencies.IsEmpty())
+	})
+}
+
+func TestClientReadRemoteNetworkDependenciesWithEmptyID(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Remote Network Dependencies With Empty ID", func(t *testing.T) {
+		client := newHTTPMockClient()
+
+		dependencies, err := client.ReadRemoteNetworkDependencies(context.Background(), "")
+
+		assert.EqualError(t, err, "failed to read remote network: network id is empty")
+		assert.Nil(t, dependencies)
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
 
 	api "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/test/cassette"
 	"github.com/hasura/go-graphql-client"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -149,64 +153,7 @@ func TestClientResourceReadOk(t *testing.T) {
 			IsBrowserShortcutEnabled: &defaultBool,
 		}
 
-		jsonResponse := fmt.Sprintf(`{
-		  "data": {
-		    "resource": {
-		      "id": "resource1",
-		      "name": "test resource",
-		      "address": {
-		        "value": "test.com"
-		      },
-		      "remoteNetwork": {
-		        "id": "network1"
-		      },
-		      "access": {
-		        "pageInfo": {
-		          "hasNextPage": false
-		        },
-		        "edges": [
-		          {
-		            "node": {
-		              "__typename": "Group",
-		              "id": "group1"
-		            }
-		          },
-		          {
-		            "node": {
-		              "__typename": "Group",
-		              "id": "group2"
-		            }
-		          }
-		        ]
-		      },
-		      "protocols": {
-		        "udp": {
-		          "ports": [],
-		          "policy": "%s"
-		        },
-		        "tcp": {
-		          "ports": [
-		            {
-		              "end": 80,
-		              "start": 80
-		            },
-		            {
-		              "end": 8090,
-		              "start": 8080
-		            }
-		          ],
-		          "policy": "%s"
-		        },
-		        "allowIcmp": true
-		      }
-		    }
-		  }
-		}`, model.PolicyAllowAll, model.PolicyRestricted)
-
-		client := newHTTPMockClient()
-		defer httpmock.DeactivateAndReset()
-		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
-			httpmock.NewStringResponder(200, jsonResponse))
+		client := cassette.NewClient(t, "resource_read")
 
 		resource, err := client.ReadResource(context.Background(), "resource1")
 		assert.Nil(t, err)
@@ -248,98 +195,7 @@ func TestClientResourceReadAllGroups(t *testing.T) {
 			IsBrowserShortcutEnabled: &defaultBool,
 		}
 
-		jsonResponse := fmt.Sprintf(`{
-		  "data": {
-		    "resource": {
-		      "id": "resource1",
-		      "name": "test resource",
-		      "address": {
-		        "value": "test.com"
-		      },
-		      "remoteNetwork": {
-		        "id": "network1"
-		      },
-		      "access": {
-		        "pageInfo": {
-		          "endCursor": "cur001",
-		          "hasNextPage": true
-		        },
-		        "edges": [
-		          {
-		            "node": {
-		              "__typename": "Group",
-		              "id": "group1"
-		            }
-		          },
-		          {
-		            "node": {
-		              "__typename": "Group",
-		              "id": "group2"
-		            }
-		          }
-		        ]
-		      },
-		      "isActive": true,
-		      "protocols": {
-		        "udp": {
-		          "ports": [],
-		          "policy": "%s"
-		        },
-		        "tcp": {
-		          "ports": [
-		            {
-		              "end": 80,
-		              "start": 80
-		            },
-		            {
-		              "end": 8090,
-		              "start": 8080
-		            }
-		          ],
-		          "policy": "%s"
-		        },
-		        "allowIcmp": true
-		      }
-		    }
-		  }
-		}`, model.PolicyAllowAll, model.PolicyRestricted)
-
-		nextPageJson := fmt.Sprintf(`{
-		  "data": {
-		    "resource": {
-		      "id": "resource1",
-		      "access": {
-		        "pageInfo": {
-		          "hasNextPage": false
-		        },
-		        "edges": [
-		          {
-		            "node": {
-		              "__typename": "Group",
-		              "id": "group3"
-		            }
-		          },
-		          {
-		            "node": {
-		              "__typename": "Group",
-		              "id": "group4"
-		            }
-		          }
-		        ]
-		      }
-		    }
-		  }
-		}`)
-
-		client := newHTTPMockClient()
-		defer httpmock.DeactivateAndReset()
-		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
-			httpmock.ResponderFromMultipleResponses(
-				[]*http.Response{
-					httpmock.NewStringResponse(200, jsonResponse),
-					httpmock.NewStringResponse(200, nextPageJson),
-				}),
-		)
+		client := cassette.NewClient(t, "resource_read_all_groups")
 
 		resource, err := client.ReadResource(context.Background(), "resource1")
 		assert.NoError(t, err)
@@ -1637,12 +1493,11 @@ func TestClientFullResourcesReadAllOk(t *testing.T) {
 		client := newHTTPMockClient()
 		defer httpmock.DeactivateAndReset()
 		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
-			httpmock.ResponderFromMultipleResponses(
-				[]*http.Response{
-					httpmock.NewStringResponse(200, readResourcesOkJson),
-					httpmock.NewStringResponse(200, nextPage),
-					httpmock.NewStringResponse(200, resource1AccessPage),
-				}),
+			OperationResponders(map[string]httpmock.Responder{
+				"readFullResources":               httpmock.NewStringResponder(200, readResourcesOkJson),
+				"readFullResourcesAfter":          httpmock.NewStringResponder(200, nextPage),
+				"readExtendedResourceAccessAfter": httpmock.NewStringResponder(200, resource1AccessPage),
+			}),
 		)
 
 		resources, err := client.ReadFullResources(context.Background())
@@ -1829,3 +1684,120 @@ func TestClientFullResourcesReadAllEmptyResponseOnExtendedCall(t *testing.T) {
 		assert.EqualError(t, err, `failed to read resource with id resource1: query result is empty`)
 	})
 }
+
+func batchResourceJSON(id string) string {
+	return fmt.Sprintf(`{
+	  "id": "%s",
+	  "name": "test resource",
+	  "address": {
+	    "value": "test.com"
+	  },
+	  "remoteNetwork": {
+	    "id": "network1"
+	  },
+	  "access": {
+	    "pageInfo": {
+	      "hasNextPage": false
+	    },
+	    "edges": [
+	      {
+	        "node": {
+	          "__typename": "Group",
+	          "id": "group1"
+	        }
+	      }
+	    ]
+	  }
+	}`, id)
+}
+
+func readResourcesConcurrently(client *api.Client, ids ...string) ([]*model.Resource, []error) {
+	resources := make([]*model.Resource, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Go(func() {
+			resources[i], errs[i] = client.ReadResource(context.Background(), id)
+		})
+	}
+
+	wg.Wait()
+
+	return resources, errs
+}
+
+func TestClientResourceReadBatchOk(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Client Resource Batch Ok", func(t *testing.T) {
+		t.Setenv(api.EnvReadBatchWindow, "50")
+
+		jsonResponse := fmt.Sprintf(`{
+		  "data": {
+		    "r1": %s,
+		    "r2": %s
+		  }
+		}`, batchResourceJSON("resource1"), batchResourceJSON("resource2"))
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				if !strings.Contains(string(body), "r1: resource(id: $id1)") || !strings.Contains(string(body), "r2: resource(id: $id2)") {
+					return httpmock.NewStringResponse(http.StatusBadRequest, "expected a batch query"), nil
+				}
+
+				return httpmock.NewStringResponse(http.StatusOK, jsonResponse), nil
+			})
+
+		resources, errs := readResourcesConcurrently(client, "resource1", "resource2")
+
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.ElementsMatch(t, []string{"resource1", "resource2"}, []string{resources[0].ID, resources[1].ID})
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+}
+
+func TestClientResourceReadBatchPartialError(t *testing.T) {
+	t.Run("Test Twingate Resource : Read Client Resource Batch Partial Error", func(t *testing.T) {
+		t.Setenv(api.EnvReadBatchWindow, "50")
+
+		batchResponse := fmt.Sprintf(`{
+		  "errors": [
+		    {
+		      "message": "error_1",
+		      "path": ["r2"]
+		    }
+		  ],
+		  "data": {
+		    "r1": %s,
+		    "r2": null
+		  }
+		}`, batchResourceJSON("resource1"))
+
+		singleResponse := fmt.Sprintf(`{
+		  "data": {
+		    "resource": %s
+		  }
+		}`, batchResourceJSON("resource2"))
+
+		client := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", client.GraphqlServerURL,
+			func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				if strings.Contains(string(body), "r1: resource") {
+					return httpmock.NewStringResponse(http.StatusOK, batchResponse), nil
+				}
+
+				return httpmock.NewStringResponse(http.StatusOK, singleResponse), nil
+			})
+
+		resources, errs := readResourcesConcurrently(client, "resource1", "resource2")
+
+		assert.Equal(t, []error{nil, nil}, errs)
+		assert.ElementsMatch(t, []string{"resource1", "resource2"}, []string{resources[0].ID, resources[1].ID})
+		assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	})
+}
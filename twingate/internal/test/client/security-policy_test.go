@@ -18,15 +18,51 @@ var requestError = errors.New("request error")
 func TestClientSecurityPolicyReadOk(t *testing.T) {
 	t.Run("Test Twingate Resource : Security Policy Read - Ok", func(t *testing.T) {
 		expected := &model.SecurityPolicy{
-			ID:   "id",
-			Name: "name",
+			ID:         "id",
+			Name:       "name",
+			PolicyType: "RESOURCE",
+			Groups:     []string{"group-1", "group-2"},
 		}
 
 		jsonResponse := `{
 		  "data": {
 		    "securityPolicy": {
 		      "id": "id",
-		      "name": "name"
+		      "name": "name",
+		      "policyType": "RESOURCE",
+		      "groups": {
+		        "pageInfo": {
+		          "endCursor": "cursor-1",
+		          "hasNextPage": true
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "group-1"
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		nextPage := `{
+		  "data": {
+		    "securityPolicy": {
+		      "id": "id",
+		      "groups": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "group-2"
+		            }
+		          }
+		        ]
+		      }
 		    }
 		  }
 		}`
@@ -34,7 +70,10 @@ func TestClientSecurityPolicyReadOk(t *testing.T) {
 		c := newHTTPMockClient()
 		defer httpmock.DeactivateAndReset()
 		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
-			httpmock.NewStringResponder(http.StatusOK, jsonResponse),
+			MultipleResponders(
+				httpmock.NewStringResponder(http.StatusOK, jsonResponse),
+				httpmock.NewStringResponder(http.StatusOK, nextPage),
+			),
 		)
 
 		securityPolicy, err := c.ReadSecurityPolicy(context.Background(), "id", "")
@@ -47,8 +86,9 @@ func TestClientSecurityPolicyReadOk(t *testing.T) {
 func TestClientSecurityPolicyReadByNameOk(t *testing.T) {
 	t.Run("Test Twingate Resource : Security Policy Read By Name - Ok", func(t *testing.T) {
 		expected := &model.SecurityPolicy{
-			ID:   "id",
-			Name: "name",
+			ID:     "id",
+			Name:   "name",
+			Groups: []string{},
 		}
 
 		jsonResponse := `{
@@ -131,12 +171,14 @@ func TestClientSecurityPoliciesReadOk(t *testing.T) {
 	t.Run("Test Twingate Resource : Security Policies Read - Ok", func(t *testing.T) {
 		expected := []*model.SecurityPolicy{
 			{
-				ID:   "policy-1",
-				Name: "name-1",
+				ID:     "policy-1",
+				Name:   "name-1",
+				Groups: []string{},
 			},
 			{
-				ID:   "policy-2",
-				Name: "name-2",
+				ID:     "policy-2",
+				Name:   "name-2",
+				Groups: []string{},
 			},
 		}
 
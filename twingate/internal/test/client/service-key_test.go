@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/jarcoal/httpmock"
@@ -240,6 +241,126 @@ func TestReadServiceKeyEmptyResponse(t *testing.T) {
 	})
 }
 
+func TestReadServiceKeysOk(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys - Ok", func(t *testing.T) {
+		createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		expiresAt := time.Date(2124, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		jsonResponse := `{
+		  "data": {
+		    "serviceAccount": {
+		      "id": "service-account-id",
+		      "name": "service-account-test",
+		      "keys": {
+		        "pageInfo": {
+		          "endCursor": "cursor-1",
+		          "hasNextPage": true
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "key-1",
+		              "name": "key-name-1",
+		              "status": "REVOKED",
+		              "createdAt": "2024-01-01T00:00:00Z",
+		              "serviceAccount": {
+		                "id": "service-account-id"
+		              }
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		nextPage := `{
+		  "data": {
+		    "serviceAccount": {
+		      "id": "service-account-id",
+		      "name": "service-account-test",
+		      "keys": {
+		        "pageInfo": {
+		          "hasNextPage": false
+		        },
+		        "edges": [
+		          {
+		            "node": {
+		              "id": "key-2",
+		              "name": "key-name-2",
+		              "status": "ACTIVE",
+		              "createdAt": "2024-01-01T00:00:00Z",
+		              "expiresAt": "2124-01-01T00:00:00Z",
+		              "serviceAccount": {
+		                "id": "service-account-id"
+		              }
+		            }
+		          }
+		        ]
+		      }
+		    }
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.ResponderFromMultipleResponses(
+				[]*http.Response{
+					httpmock.NewStringResponse(http.StatusOK, jsonResponse),
+					httpmock.NewStringResponse(http.StatusOK, nextPage),
+				}),
+		)
+
+		keys, err := c.ReadServiceKeys(context.Background(), "service-account-id")
+
+		assert.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.Equal(t, &model.ServiceKey{
+			ID:        "key-1",
+			Name:      "key-name-1",
+			Status:    model.StatusRevoked,
+			Service:   "service-account-id",
+			CreatedAt: createdAt,
+		}, keys[0])
+		assert.Equal(t, "key-2", keys[1].ID)
+		assert.Equal(t, model.StatusActive, keys[1].Status)
+		assert.Equal(t, createdAt, keys[1].CreatedAt)
+		assert.Equal(t, &expiresAt, keys[1].ExpiresAt)
+	})
+}
+
+func TestReadServiceKeysWithEmptyID(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys - With Empty ID", func(t *testing.T) {
+		c := newHTTPMockClient()
+
+		keys, err := c.ReadServiceKeys(context.Background(), "")
+
+		assert.Nil(t, keys)
+		assert.EqualError(t, err, `failed to read service account key: id is empty`)
+	})
+}
+
+func TestReadServiceKeysEmptyResponse(t *testing.T) {
+	t.Run("Test Twingate Resource: Read Service Keys - Empty Response", func(t *testing.T) {
+		jsonResponse := `{
+		  "data": {
+		    "serviceAccount": null
+		  }
+		}`
+
+		c := newHTTPMockClient()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("POST", c.GraphqlServerURL,
+			httpmock.NewStringResponder(http.StatusOK, jsonResponse))
+
+		keys, err := c.ReadServiceKeys(context.Background(), "service-account-id")
+
+		assert.Nil(t, keys)
+		assert.EqualError(t, err, `failed to read service account key with id service-account-id: query result is empty`)
+	})
+}
+
 func TestUpdateServiceKeyOk(t *testing.T) {
 	t.Run("Test Twingate Resource : Update Service Key - Ok", func(t *testing.T) {
 		expected := &model.ServiceKey{
@@ -6,6 +6,8 @@ import (
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
+	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,6 +32,7 @@ func TestConnectorModel(t *testing.T) {
 				attr.ID:                   "",
 				attr.Name:                 "",
 				attr.RemoteNetworkID:      "",
+				attr.RemoteNetworkType:    "",
 				attr.StatusUpdatesEnabled: false,
 				attr.State:                "",
 				attr.Version:              "",
@@ -43,6 +46,7 @@ func TestConnectorModel(t *testing.T) {
 				ID:                   "id",
 				Name:                 "name",
 				NetworkID:            "network-id",
+				NetworkType:          model.NetworkTypeExit,
 				StatusUpdatesEnabled: &boolTrue,
 				State:                "DEAD_NO_HEARTBEAT",
 				Version:              "0.1",
@@ -56,6 +60,7 @@ func TestConnectorModel(t *testing.T) {
 				attr.ID:                   "id",
 				attr.Name:                 "name",
 				attr.RemoteNetworkID:      "network-id",
+				attr.RemoteNetworkType:    model.NetworkTypeExit,
 				attr.StatusUpdatesEnabled: true,
 				attr.State:                "DEAD_NO_HEARTBEAT",
 				attr.Version:              "0.1",
@@ -74,3 +79,63 @@ func TestConnectorModel(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectorsFilterMatch(t *testing.T) {
+	connector := &model.Connector{
+		ID:          "id",
+		NetworkID:   "network-id",
+		NetworkType: model.NetworkTypeRegular,
+		State:       model.ConnectorStateDeadNoHeartbeat,
+		Version:     "1.65.2",
+		Hostname:    "Connector-Host",
+		PublicIP:    "203.0.113.10",
+		PrivateIPs:  []string{"10.0.1.5"},
+	}
+
+	mustConstraint := func(constraint string) version.Constraints {
+		constraints, err := version.NewConstraint(constraint)
+		assert.NoError(t, err)
+
+		return constraints
+	}
+
+	mustAddress := func(address string) *utils.Address {
+		parsed, err := utils.ParseAddress(address)
+		assert.NoError(t, err)
+
+		return parsed
+	}
+
+	cases := []struct {
+		filter   *model.ConnectorsFilter
+		expected bool
+	}{
+		{filter: nil, expected: true},
+		{filter: &model.ConnectorsFilter{}, expected: true},
+		{filter: &model.ConnectorsFilter{States: []string{model.ConnectorStateDeadNoHeartbeat, model.ConnectorStateDeadNoRelays}}, expected: true},
+		{filter: &model.ConnectorsFilter{States: []string{model.ConnectorStateAlive}}, expected: false},
+		{filter: &model.ConnectorsFilter{Version: mustConstraint("< 1.70.0")}, expected: true},
+		{filter: &model.ConnectorsFilter{Version: mustConstraint(">= 1.60.0, < 1.65.0")}, expected: false},
+		{filter: &model.ConnectorsFilter{RemoteNetworkID: "network-id"}, expected: true},
+		{filter: &model.ConnectorsFilter{RemoteNetworkID: "other-network-id"}, expected: false},
+		{filter: &model.ConnectorsFilter{RemoteNetworkType: model.NetworkTypeExit}, expected: false},
+		{filter: &model.ConnectorsFilter{Hostname: "connector-host"}, expected: true},
+		{filter: &model.ConnectorsFilter{Hostname: "other-host"}, expected: false},
+		{filter: &model.ConnectorsFilter{IPAddress: mustAddress("203.0.113.10")}, expected: true},
+		{filter: &model.ConnectorsFilter{IPAddress: mustAddress("10.0.0.0/16")}, expected: true},
+		{filter: &model.ConnectorsFilter{IPAddress: mustAddress("192.168.0.0/16")}, expected: false},
+		{filter: &model.ConnectorsFilter{States: []string{model.ConnectorStateDeadNoHeartbeat}, Hostname: "other-host"}, expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.filter.Match(connector))
+		})
+	}
+
+	t.Run("connector without version", func(t *testing.T) {
+		filter := &model.ConnectorsFilter{Version: mustConstraint("< 1.70.0")}
+
+		assert.False(t, filter.Match(&model.Connector{}))
+	})
+}
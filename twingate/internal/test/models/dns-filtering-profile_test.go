@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,3 +36,168 @@ func TestDnsFilteringProfileModel(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSFilteringProfileOrderPriorities(t *testing.T) {
+	cases := []struct {
+		order    model.DNSFilteringProfileOrder
+		expected map[string]float64
+	}{
+		{
+			order:    model.DNSFilteringProfileOrder{BasePriority: 1, PriorityStep: 1},
+			expected: map[string]float64{},
+		},
+		{
+			order: model.DNSFilteringProfileOrder{
+				ProfileIDs:   []string{"a", "b", "c"},
+				BasePriority: 1,
+				PriorityStep: 1,
+			},
+			expected: map[string]float64{"a": 3, "b": 2, "c": 1},
+		},
+		{
+			order: model.DNSFilteringProfileOrder{
+				ProfileIDs:   []string{"a", "b"},
+				BasePriority: 10,
+				PriorityStep: 0.5,
+			},
+			expected: map[string]float64{"a": 10.5, "b": 10},
+		},
+		{
+			order: model.DNSFilteringProfileOrder{
+				ProfileIDs:   []string{"a", "b", "c", "d"},
+				BasePriority: 0.9,
+				PriorityStep: 0.1,
+			},
+			expected: map[string]float64{"a": 1.2, "b": 1.1, "c": 1, "d": 0.9},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.order.Priorities())
+		})
+	}
+}
+
+func TestDNSFilteringProfileOrderCollisions(t *testing.T) {
+	order := model.DNSFilteringProfileOrder{
+		ProfileIDs:   []string{"a", "b"},
+		BasePriority: 1,
+		PriorityStep: 1,
+	}
+
+	profiles := []*model.DNSFilteringProfile{
+		{ID: "a", Priority: 1},
+		{ID: "b", Priority: 5},
+		{ID: "x", Priority: 2},
+		{ID: "y", Priority: 3},
+	}
+
+	assert.Equal(t, []*model.DNSFilteringProfile{{ID: "x", Priority: 2}}, order.Collisions(profiles))
+	assert.Nil(t, order.Collisions(profiles[:2]))
+}
+
+func TestDNSFilteringProfileOrderCollisionsRounded(t *testing.T) {
+	order := model.DNSFilteringProfileOrder{
+		ProfileIDs:   []string{"a", "b", "c", "d"},
+		BasePriority: 0.9,
+		PriorityStep: 0.1,
+	}
+
+	profiles := []*model.DNSFilteringProfile{{ID: "x", Priority: 1.2}, {ID: "y", Priority: 1.25}}
+
+	assert.Equal(t, []*model.DNSFilteringProfile{{ID: "x", Priority: 1.2}}, order.Collisions(profiles))
+}
+
+func TestRoundPriority(t *testing.T) {
+	base, step := 0.9, 0.1
+
+	assert.Equal(t, 1.2, model.RoundPriority(base+step*3))
+	assert.Equal(t, 1.23, model.RoundPriority(1.234))
+}
+
+func TestDNSFilteringProfilesFilterMatch(t *testing.T) {
+	profile := &model.DNSFilteringProfile{
+		Name:           "Engineering Profile",
+		Priority:       2,
+		FallbackMethod: model.FallbackMethodAuto,
+	}
+
+	low, high := 1.0, 1.5
+
+	cases := []struct {
+		filter   *model.DNSFilteringProfilesFilter
+		expected bool
+	}{
+		{
+			filter:   nil,
+			expected: true,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{},
+			expected: true,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{Name: "Engineering Profile"},
+			expected: true,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{Name: "Engineering"},
+			expected: false,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{Name: "Engineering", NameFilter: attr.FilterByPrefix},
+			expected: true,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{Name: "Profile", NameFilter: attr.FilterByExclude},
+			expected: false,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{Name: "^Eng.*e$", NameFilter: attr.FilterByRegexp},
+			expected: true,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{MinPriority: &low},
+			expected: true,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{MinPriority: &low, MaxPriority: &high},
+			expected: false,
+		},
+		{
+			filter:   &model.DNSFilteringProfilesFilter{FallbackMethod: model.FallbackMethodStrict},
+			expected: false,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.filter.Match(profile))
+		})
+	}
+}
+
+func TestDNSFilteringProfilesFilterMatchShallow(t *testing.T) {
+	// the list of profiles has no fallback method
+	profile := &model.DNSFilteringProfile{Name: "Engineering Profile", Priority: 2}
+	filter := &model.DNSFilteringProfilesFilter{Name: "Engineering", NameFilter: attr.FilterByPrefix, FallbackMethod: model.FallbackMethodStrict}
+
+	assert.True(t, filter.MatchShallow(profile))
+	assert.False(t, filter.Match(profile))
+	assert.False(t, (&model.DNSFilteringProfilesFilter{Name: "Profile", NameFilter: attr.FilterByPrefix}).MatchShallow(profile))
+	assert.True(t, (*model.DNSFilteringProfilesFilter)(nil).MatchShallow(profile))
+}
+
+func TestNewDNSFilteringCoverage(t *testing.T) {
+	profiles := []*model.DNSFilteringProfile{
+		{ID: "p2", Groups: []string{"g1", "g2"}},
+		{ID: "p1", Groups: []string{"g1"}},
+		{ID: "p3", Groups: []string{"g3"}},
+	}
+
+	coverage := model.NewDNSFilteringCoverage(profiles, []string{"g5", "g1", "g4"})
+
+	assert.Equal(t, map[string][]string{"g1": {"p1", "p2"}}, coverage.OverlappingGroups)
+	assert.Equal(t, []string{"g4", "g5"}, coverage.UncoveredGroups)
+}
@@ -24,6 +24,7 @@ func TestRemoteNetworkModel(t *testing.T) {
 				attr.ID:       "",
 				attr.Name:     "",
 				attr.Location: "",
+				attr.Type:     "",
 			},
 		},
 		{
@@ -31,11 +32,13 @@ func TestRemoteNetworkModel(t *testing.T) {
 				ID:       "id",
 				Name:     "name",
 				Location: model.LocationGoogleCloud,
+				Type:     model.NetworkTypeExit,
 			},
 			expected: map[string]any{
 				attr.ID:       "id",
 				attr.Name:     "name",
 				attr.Location: model.LocationGoogleCloud,
+				attr.Type:     model.NetworkTypeExit,
 			},
 			expectedID:       "id",
 			expectedName:     "name",
@@ -52,3 +55,41 @@ func TestRemoteNetworkModel(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteNetworkDependencies(t *testing.T) {
+	cases := []struct {
+		dependencies model.RemoteNetworkDependencies
+
+		expectedEmpty bool
+		expected      []string
+	}{
+		{
+			dependencies:  model.RemoteNetworkDependencies{},
+			expectedEmpty: true,
+			expected:      []string{},
+		},
+		{
+			dependencies: model.RemoteNetworkDependencies{
+				Connectors:          []*model.Connector{{ID: "c1", Name: "connector"}},
+				Resources:           []*model.Resource{{ID: "r1", Name: "resource"}},
+				SSHResources:        []*model.SSHResource{{ID: "s1", Name: "ssh"}},
+				KubernetesResources: []*model.KubernetesResource{{ID: "k1", Name: "k8s"}},
+				Gateways:            []*model.Gateway{{ID: "g1", Address: "10.0.0.1:8443"}},
+			},
+			expected: []string{
+				`connector "connector" (c1)`,
+				`resource "resource" (r1)`,
+				`SSH resource "ssh" (s1)`,
+				`Kubernetes resource "k8s" (k1)`,
+				`gateway "10.0.0.1:8443" (g1)`,
+			},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expectedEmpty, c.dependencies.IsEmpty())
+			assert.Equal(t, c.expected, c.dependencies.Describe())
+		})
+	}
+}
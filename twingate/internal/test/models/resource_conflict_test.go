@@ -0,0 +1,143 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindResourceConflicts(t *testing.T) {
+	restrictedTCP := func(ports ...*model.PortRange) *model.Protocols {
+		return &model.Protocols{
+			TCP: model.NewProtocol(model.PolicyRestricted, ports),
+			UDP: model.NewProtocol(model.PolicyRestricted, nil),
+		}
+	}
+
+	groups := func(ids ...string) []model.AccessGroup {
+		access := make([]model.AccessGroup, 0, len(ids))
+		for _, id := range ids {
+			access = append(access, model.AccessGroup{GroupID: id})
+		}
+
+		return access
+	}
+
+	cases := []struct {
+		resources []*model.Resource
+		expected  []model.ResourceConflict
+	}{
+		{
+			resources: nil,
+			expected:  nil,
+		},
+		{
+			resources: []*model.Resource{
+				{ID: "1", RemoteNetworkID: "net", Address: "10.0.0.0/8", GroupsAccess: groups("g1")},
+				{ID: "2", RemoteNetworkID: "net", Address: "10.1.2.3", GroupsAccess: groups("g1")},
+			},
+			expected: []model.ResourceConflict{
+				{
+					Type:                       model.ConflictTypeAddressOverlap,
+					ResourceID:                 "1",
+					ResourceAddress:            "10.0.0.0/8",
+					ConflictingResourceID:      "2",
+					ConflictingResourceAddress: "10.1.2.3",
+					Description:                "address 10.0.0.0/8 overlaps with address 10.1.2.3",
+				},
+			},
+		},
+		{
+			resources: []*model.Resource{
+				{ID: "1", RemoteNetworkID: "net", Address: "DB.corp.example.com.", GroupsAccess: groups("g1")},
+				{ID: "2", RemoteNetworkID: "net", Address: "db.corp.example.com", GroupsAccess: groups("g1")},
+				{ID: "3", RemoteNetworkID: "net", Address: "*.internal.test", GroupsAccess: groups("g1")},
+				{ID: "4", RemoteNetworkID: "net", Address: "*.internal.test", GroupsAccess: groups("g1")},
+			},
+			expected: []model.ResourceConflict{
+				{
+					Type:                       model.ConflictTypeAddressOverlap,
+					ResourceID:                 "1",
+					ResourceAddress:            "DB.corp.example.com.",
+					ConflictingResourceID:      "2",
+					ConflictingResourceAddress: "db.corp.example.com",
+					Description:                "address DB.corp.example.com. is the same as address db.corp.example.com",
+				},
+				{
+					Type:                       model.ConflictTypeAddressOverlap,
+					ResourceID:                 "3",
+					ResourceAddress:            "*.internal.test",
+					ConflictingResourceID:      "4",
+					ConflictingResourceAddress: "*.internal.test",
+					Description:                "address *.internal.test is the same as address *.internal.test",
+				},
+			},
+		},
+		{
+			resources: []*model.Resource{
+				{ID: "1", RemoteNetworkID: "net", Address: "db.corp.example.com", GroupsAccess: groups("g1")},
+				{ID: "2", RemoteNetworkID: "net", Address: "*.corp.example.com", GroupsAccess: groups("g1")},
+				{ID: "3", RemoteNetworkID: "net", Address: "web.example.com", GroupsAccess: groups("g1")},
+			},
+			expected: []model.ResourceConflict{
+				{
+					Type:                       model.ConflictTypeShadowedWildcard,
+					ResourceID:                 "2",
+					ResourceAddress:            "*.corp.example.com",
+					ConflictingResourceID:      "1",
+					ConflictingResourceAddress: "db.corp.example.com",
+					Description:                "wildcard address *.corp.example.com is shadowed by address db.corp.example.com",
+				},
+			},
+		},
+		{
+			resources: []*model.Resource{
+				{ID: "1", RemoteNetworkID: "net", Address: "10.0.0.0/24", GroupsAccess: groups("g1"), Protocols: restrictedTCP(&model.PortRange{Start: 80, End: 443})},
+				{ID: "2", RemoteNetworkID: "net", Address: "10.0.0.5", GroupsAccess: groups("g2"), Protocols: restrictedTCP(&model.PortRange{Start: 443, End: 8080})},
+				{ID: "3", RemoteNetworkID: "other", Address: "10.0.0.6", GroupsAccess: groups("g3")},
+			},
+			expected: []model.ResourceConflict{
+				{
+					Type:                       model.ConflictTypeAddressOverlap,
+					ResourceID:                 "1",
+					ResourceAddress:            "10.0.0.0/24",
+					ConflictingResourceID:      "2",
+					ConflictingResourceAddress: "10.0.0.5",
+					Description:                "address 10.0.0.0/24 overlaps with address 10.0.0.5",
+				},
+				{
+					Type:                       model.ConflictTypePortCollision,
+					ResourceID:                 "1",
+					ResourceAddress:            "10.0.0.0/24",
+					ConflictingResourceID:      "2",
+					ConflictingResourceAddress: "10.0.0.5",
+					Description:                "ports tcp 443 are reachable through both Resources with differing access groups",
+				},
+			},
+		},
+		{
+			resources: []*model.Resource{
+				{ID: "1", RemoteNetworkID: "net", Address: "10.0.0.0/24", GroupsAccess: groups("g1"), Protocols: restrictedTCP(&model.PortRange{Start: 22, End: 22})},
+				{ID: "2", RemoteNetworkID: "net", Address: "10.0.0.5", GroupsAccess: groups("g2"), Protocols: restrictedTCP(&model.PortRange{Start: 443, End: 443})},
+			},
+			expected: []model.ResourceConflict{
+				{
+					Type:                       model.ConflictTypeAddressOverlap,
+					ResourceID:                 "1",
+					ResourceAddress:            "10.0.0.0/24",
+					ConflictingResourceID:      "2",
+					ConflictingResourceAddress: "10.0.0.5",
+					Description:                "address 10.0.0.0/24 overlaps with address 10.0.0.5",
+				},
+			},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, model.FindResourceConflicts(c.resources))
+		})
+	}
+}
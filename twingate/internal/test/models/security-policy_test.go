@@ -40,3 +40,45 @@ func TestSecurityPolicyModel(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSecurityPolicyUsage(t *testing.T) {
+	policyID := "policy-1"
+	otherPolicyID := "policy-2"
+
+	resources := []*model.Resource{
+		{
+			ID:               "resource-2",
+			SecurityPolicyID: &policyID,
+			GroupsAccess: []model.AccessGroup{
+				{GroupID: "group-2", SecurityPolicyID: &policyID},
+				{GroupID: "group-1", SecurityPolicyID: &policyID},
+			},
+		},
+		{
+			ID:               "resource-1",
+			SecurityPolicyID: &otherPolicyID,
+			GroupsAccess: []model.AccessGroup{
+				{GroupID: "group-3", SecurityPolicyID: &policyID},
+				{GroupID: "group-4"},
+			},
+		},
+		{
+			ID: "resource-3",
+		},
+	}
+
+	expected := &model.SecurityPolicyUsage{
+		ResourceIDs: []string{"resource-2"},
+		AccessGroups: []model.SecurityPolicyAccessGroup{
+			{ResourceID: "resource-1", GroupID: "group-3"},
+			{ResourceID: "resource-2", GroupID: "group-1"},
+			{ResourceID: "resource-2", GroupID: "group-2"},
+		},
+	}
+
+	assert.Equal(t, expected, model.NewSecurityPolicyUsage(policyID, resources))
+	assert.Equal(t, &model.SecurityPolicyUsage{
+		ResourceIDs:  []string{},
+		AccessGroups: []model.SecurityPolicyAccessGroup{},
+	}, model.NewSecurityPolicyUsage("unused", resources))
+}
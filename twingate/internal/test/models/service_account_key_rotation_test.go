@@ -0,0 +1,90 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceKeyRotation(t *testing.T) {
+	rotatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	active := func(id string) *model.ServiceKey {
+		return &model.ServiceKey{ID: id, Status: model.StatusActive}
+	}
+
+	revoked := func(id string) *model.ServiceKey {
+		return &model.ServiceKey{ID: id, Status: model.StatusRevoked}
+	}
+
+	cases := []struct {
+		rotation model.ServiceKeyRotation
+		now      time.Time
+
+		expectedCurrent  *model.ServiceKey
+		expectedPrevious *model.ServiceKey
+		expectedRotation bool
+		expectedRevoke   []*model.ServiceKey
+		expectedDelete   []*model.ServiceKey
+	}{
+		{
+			rotation:         model.ServiceKeyRotation{RotationPeriod: 24 * time.Hour, Overlap: time.Hour, Generations: 2},
+			now:              rotatedAt,
+			expectedRotation: true,
+		},
+		{
+			rotation: model.ServiceKeyRotation{
+				RotationPeriod: 24 * time.Hour, Overlap: time.Hour, Generations: 2, RotatedAt: rotatedAt,
+				Keys: []*model.ServiceKey{active("1"), active("2")},
+			},
+			now:              rotatedAt.Add(30 * time.Minute),
+			expectedCurrent:  active("1"),
+			expectedPrevious: active("2"),
+		},
+		{
+			rotation: model.ServiceKeyRotation{
+				RotationPeriod: 24 * time.Hour, Overlap: time.Hour, Generations: 2, RotatedAt: rotatedAt,
+				Keys: []*model.ServiceKey{active("1"), active("2")},
+			},
+			now:              rotatedAt.Add(2 * time.Hour),
+			expectedCurrent:  active("1"),
+			expectedPrevious: active("2"),
+			expectedRevoke:   []*model.ServiceKey{active("2")},
+		},
+		{
+			rotation: model.ServiceKeyRotation{
+				RotationPeriod: 24 * time.Hour, Overlap: time.Hour, Generations: 2, RotatedAt: rotatedAt,
+				Keys: []*model.ServiceKey{active("1"), revoked("2"), active("3")},
+			},
+			now:              rotatedAt.Add(24 * time.Hour),
+			expectedCurrent:  active("1"),
+			expectedPrevious: revoked("2"),
+			expectedRotation: true,
+			expectedRevoke:   []*model.ServiceKey{active("3")},
+			expectedDelete:   []*model.ServiceKey{active("3")},
+		},
+		{
+			rotation: model.ServiceKeyRotation{
+				RotationPeriod: 24 * time.Hour, Overlap: time.Hour, Generations: 2, RotatedAt: rotatedAt,
+				Keys: []*model.ServiceKey{revoked("1")},
+			},
+			now:              rotatedAt,
+			expectedCurrent:  revoked("1"),
+			expectedRotation: true,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expectedCurrent, c.rotation.Current())
+			assert.Equal(t, c.expectedPrevious, c.rotation.Previous())
+			assert.Equal(t, c.expectedRotation, c.rotation.NeedsRotation(c.now))
+			assert.Equal(t, c.expectedRevoke, c.rotation.KeysToRevoke(c.now))
+			assert.Equal(t, c.expectedDelete, c.rotation.KeysToDelete())
+			assert.Equal(t, c.expectedRotation || len(c.expectedRevoke) > 0 || len(c.expectedDelete) > 0, c.rotation.NeedsUpdate(c.now))
+		})
+	}
+}
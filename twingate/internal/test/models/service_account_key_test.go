@@ -3,6 +3,7 @@ package models
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	"github.com/stretchr/testify/assert"
@@ -39,3 +40,50 @@ func TestServiceAccountKeyModel(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAccountKeyExpiry(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	inTenDays := now.Add(10*24*time.Hour + time.Minute)
+	dayAgo := now.Add(-24 * time.Hour)
+
+	days := func(val int) *int {
+		return &val
+	}
+
+	cases := []struct {
+		key    model.ServiceKey
+		window time.Duration
+
+		expectedDays   *int
+		expectedWithin bool
+	}{
+		{
+			key:    model.ServiceKey{},
+			window: 24 * time.Hour,
+		},
+		{
+			key:          model.ServiceKey{ExpiresAt: &inTenDays},
+			window:       7 * 24 * time.Hour,
+			expectedDays: days(10),
+		},
+		{
+			key:            model.ServiceKey{ExpiresAt: &inTenDays},
+			window:         30 * 24 * time.Hour,
+			expectedDays:   days(10),
+			expectedWithin: true,
+		},
+		{
+			key:            model.ServiceKey{ExpiresAt: &dayAgo},
+			window:         time.Hour,
+			expectedDays:   days(-1),
+			expectedWithin: true,
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expectedDays, c.key.DaysUntilExpiry(now))
+			assert.Equal(t, c.expectedWithin, c.key.ExpiresWithin(now, c.window))
+		})
+	}
+}
@@ -178,3 +178,79 @@ func TestUserUpdateState(t *testing.T) {
 		})
 	}
 }
+
+func TestAssignUserGroups(t *testing.T) {
+	users := []*model.User{{ID: "user-1"}, {ID: "user-2"}, {ID: "user-3"}}
+	groups := []*model.Group{
+		{ID: "group-b", Type: model.GroupTypeManual, Users: []string{"user-1"}},
+		{ID: "group-a", Type: model.GroupTypeSynced, Users: []string{"user-1", "user-2"}},
+		{ID: "everyone", Type: model.GroupTypeSystem, Users: []string{"user-1", "user-2", "user-3"}},
+	}
+
+	model.AssignUserGroups(users, groups)
+
+	assert.Equal(t, []string{"group-a", "group-b"}, users[0].Groups)
+	assert.Equal(t, []string{"group-a"}, users[1].Groups)
+	assert.Equal(t, []string{}, users[2].Groups)
+}
+
+func TestUsersFilterMatch(t *testing.T) {
+	user := &model.User{
+		ID:       "user-id",
+		Type:     model.UserTypeSynced,
+		IsActive: false,
+		Groups:   []string{"group-1"},
+	}
+
+	cases := []struct {
+		filter   *model.UsersFilter
+		expected bool
+	}{
+		{filter: nil, expected: true},
+		{filter: &model.UsersFilter{}, expected: true},
+		{filter: &model.UsersFilter{State: model.UserStateDisabled}, expected: true},
+		{filter: &model.UsersFilter{State: model.UserStateActive}, expected: false},
+		{filter: &model.UsersFilter{Type: model.UserTypeSynced}, expected: true},
+		{filter: &model.UsersFilter{Type: model.UserTypeManual}, expected: false},
+		{filter: &model.UsersFilter{MemberOfGroup: "group-1"}, expected: true},
+		{filter: &model.UsersFilter{MemberOfGroup: "group-2"}, expected: false},
+		{filter: &model.UsersFilter{NotInAnyGroup: true}, expected: false},
+		{filter: &model.UsersFilter{State: model.UserStateDisabled, MemberOfGroup: "group-2"}, expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			assert.Equal(t, c.expected, c.filter.Match(user))
+		})
+	}
+
+	t.Run("user without groups", func(t *testing.T) {
+		filter := &model.UsersFilter{NotInAnyGroup: true}
+
+		assert.True(t, filter.Match(&model.User{Groups: []string{}}))
+	})
+}
+
+func TestUserGroupChanges(t *testing.T) {
+	current := []*model.User{
+		{ID: "user-1", Groups: []string{"group-1", "group-2"}},
+		{ID: "user-2", Groups: []string{"group-1"}},
+		{ID: "user-3", Groups: []string{"group-2"}},
+	}
+	planned := []*model.User{
+		{ID: "user-1", Groups: []string{"group-1", "group-3"}},
+		{ID: "user-2", Groups: []string{"group-1", "group-3"}},
+		{ID: "user-4", Groups: []string{"group-2"}},
+		{ID: "", Groups: []string{"group-1"}},
+	}
+
+	added, removed := model.UserGroupChanges(current, planned)
+
+	assert.Equal(t, map[string][]string{
+		"group-2": {"user-4"},
+		"group-3": {"user-1", "user-2"},
+	}, added)
+	assert.Equal(t, map[string][]string{
+		"group-2": {"user-1", "user-3"},
+	}, removed)
+}
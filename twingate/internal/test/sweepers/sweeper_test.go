@@ -47,8 +47,8 @@ func sharedClient(tenant string) (*client.Client, error) {
 	return client.NewClient(context.Background(),
 			fmt.Sprintf("https://%s.%s", os.Getenv(twingate.EnvNetwork), os.Getenv(twingate.EnvURL)),
 			os.Getenv(twingate.EnvAPIToken),
-			getEnv(twingate.EnvHTTPTimeout, 30*time.Second),
-			2,
+			client.DefaultOperationOptions(getEnv(twingate.EnvHTTPTimeout, 30*time.Second), 2),
+			nil,
 			client.DefaultAgent,
 			"sweeper",
 			client.CacheOptions{}),
@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+type AddressType int
+
+const (
+	AddressTypeFQDN AddressType = iota
+	AddressTypeWildcardFQDN
+	AddressTypeIP
+	AddressTypeCIDR
+	AddressTypeIPRange
+)
+
+const (
+	maxHostnameLen   = 253
+	ipRangeSeparator = "-"
+	ipRangeParts     = 2
+)
+
+var (
+	ErrEmptyAddress     = errors.New("address is empty")
+	ErrCIDRHostBitsSet  = errors.New("CIDR has host bits set")
+	ErrIPRangeMixed     = errors.New("IP range must use a single address family")
+	ErrIPRangeNotRising = errors.New("IP range start must not be greater than its end")
+	ErrHostnameTooLong  = errors.New("hostname is too long")
+
+	// Matches a single DNS label, wildcards `*` and `?` are allowed anywhere in the label.
+	hostnameLabelRgxp = regexp.MustCompile(`^[a-zA-Z0-9_*?](?:[a-zA-Z0-9_*?-]{0,61}[a-zA-Z0-9_*?])?$`)
+	numericLabelRgxp  = regexp.MustCompile(`^\d+$`)
+)
+
+// Address is a parsed Resource address: FQDN, wildcard FQDN, IP, CIDR or IP range.
+type Address struct {
+	Type  AddressType
+	Value string
+
+	// Start and End are the first and the last IP covered by an IP based address.
+	Start netip.Addr
+	End   netip.Addr
+}
+
+// ParseAddress validates and parses a Resource address.
+func ParseAddress(value string) (*Address, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, ErrEmptyAddress
+	}
+
+	if strings.Contains(value, "/") {
+		return parseCIDR(value)
+	}
+
+	if ip, err := netip.ParseAddr(value); err == nil {
+		return &Address{Type: AddressTypeIP, Value: value, Start: ip, End: ip}, nil
+	}
+
+	if parts := strings.Split(value, ipRangeSeparator); len(parts) == ipRangeParts {
+		if _, err := netip.ParseAddr(parts[0]); err == nil {
+			return parseIPRange(value, parts[0], parts[1])
+		}
+	}
+
+	if strings.Contains(value, ":") || looksLikeIPv4(value) {
+		return nil, fmt.Errorf("invalid IP address: %q", value) //nolint:err113
+	}
+
+	return parseHostname(value)
+}
+
+func parseCIDR(value string) (*Address, error) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q: %w", value, err)
+	}
+
+	if prefix.Masked().Addr() != prefix.Addr() {
+		return nil, fmt.Errorf("%w: %q, expected %q", ErrCIDRHostBitsSet, value, prefix.Masked().String())
+	}
+
+	return &Address{
+		Type:  AddressTypeCIDR,
+		Value: value,
+		Start: prefix.Addr(),
+		End:   lastAddr(prefix),
+	}, nil
+}
+
+func parseIPRange(value, startStr, endStr string) (*Address, error) {
+	start, err := netip.ParseAddr(startStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP range %q: %w", value, err)
+	}
+
+	end, err := netip.ParseAddr(endStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP range %q: %w", value, err)
+	}
+
+	if start.Is4() != end.Is4() {
+		return nil, fmt.Errorf("%w: %q", ErrIPRangeMixed, value)
+	}
+
+	if end.Less(start) {
+		return nil, fmt.Errorf("%w: %q", ErrIPRangeNotRising, value)
+	}
+
+	return &Address{Type: AddressTypeIPRange, Value: value, Start: start, End: end}, nil
+}
+
+func parseHostname(value string) (*Address, error) {
+	hostname := strings.TrimSuffix(value, ".")
+	if len(hostname) > maxHostnameLen {
+		return nil, fmt.Errorf("%w: %q", ErrHostnameTooLong, value)
+	}
+
+	for _, label := range strings.Split(hostname, ".") {
+		if !hostnameLabelRgxp.MatchString(label) {
+			return nil, fmt.Errorf("invalid hostname %q: malformed label %q", value, label) //nolint:err113
+		}
+	}
+
+	addressType := AddressTypeFQDN
+	if strings.ContainsAny(hostname, "*?") {
+		addressType = AddressTypeWildcardFQDN
+	}
+
+	return &Address{Type: addressType, Value: strings.ToLower(hostname)}, nil
+}
+
+// looksLikeIPv4 reports whether every dot separated label is numeric, e.g. "10.0.0.300".
+func looksLikeIPv4(value string) bool {
+	for _, label := range strings.Split(value, ".") {
+		if !numericLabelRgxp.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	const bitsInByte = 8
+
+	bytes := prefix.Addr().As16()
+	offset := 0
+
+	if prefix.Addr().Is4() {
+		// IPv4 addresses are stored in the last 4 bytes of As16.
+		offset = 12
+	}
+
+	for bit := prefix.Bits(); bit < prefix.Addr().BitLen(); bit++ {
+		idx := offset + bit/bitsInByte
+		bytes[idx] |= 1 << (bitsInByte - 1 - bit%bitsInByte)
+	}
+
+	addr := netip.AddrFrom16(bytes)
+	if prefix.Addr().Is4() {
+		return addr.Unmap()
+	}
+
+	return addr
+}
+
+// IsIPBased reports whether the address is an IP, CIDR or IP range.
+func (a *Address) IsIPBased() bool {
+	return a.Type == AddressTypeIP || a.Type == AddressTypeCIDR || a.Type == AddressTypeIPRange
+}
+
+// IsWildcard reports whether the address covers more than a single host.
+func (a *Address) IsWildcard() bool {
+	return a.Type == AddressTypeWildcardFQDN || a.Type == AddressTypeCIDR || a.Type == AddressTypeIPRange
+}
+
+// Overlaps reports whether two IP based addresses share at least one IP.
+func (a *Address) Overlaps(another *Address) bool {
+	if a == nil || another == nil || !a.IsIPBased() || !another.IsIPBased() {
+		return false
+	}
+
+	if a.Start.Is4() != another.Start.Is4() {
+		return false
+	}
+
+	return !a.End.Less(another.Start) && !another.End.Less(a.Start)
+}
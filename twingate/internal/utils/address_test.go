@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	cases := []struct {
+		address      string
+		expectedType AddressType
+		expectedErr  bool
+	}{
+		{address: "acc-test.com", expectedType: AddressTypeFQDN},
+		{address: "acc-test.com.16", expectedType: AddressTypeFQDN},
+		{address: "internal", expectedType: AddressTypeFQDN},
+		{address: "*.acc-test.com", expectedType: AddressTypeWildcardFQDN},
+		{address: "redis-?-blah.internal", expectedType: AddressTypeWildcardFQDN},
+		{address: "10.0.0.1", expectedType: AddressTypeIP},
+		{address: "2001:db8::1", expectedType: AddressTypeIP},
+		{address: "10.0.0.0/16", expectedType: AddressTypeCIDR},
+		{address: "2001:db8::/32", expectedType: AddressTypeCIDR},
+		{address: "10.0.0.1-10.0.0.50", expectedType: AddressTypeIPRange},
+		{address: "", expectedErr: true},
+		{address: "10.0.0.300", expectedErr: true},
+		{address: "10.0.0.1/16", expectedErr: true},
+		{address: "10.0.0.0/33", expectedErr: true},
+		{address: "10.0.0.50-10.0.0.1", expectedErr: true},
+		{address: "10.0.0.1-2001:db8::1", expectedErr: true},
+		{address: "10.0.0.1-10.0.0.300", expectedErr: true},
+		{address: "2001:db8::zz", expectedErr: true},
+		{address: "-bad.example.com", expectedErr: true},
+		{address: "bad..example.com", expectedErr: true},
+		{address: "https://vault.example.com", expectedErr: true},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			address, err := ParseAddress(c.address)
+
+			if c.expectedErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expectedType, address.Type)
+		})
+	}
+}
+
+func TestAddressOverlaps(t *testing.T) {
+	cases := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{a: "10.0.0.0/8", b: "10.1.2.3", expected: true},
+		{a: "10.0.0.0/16", b: "10.0.255.255", expected: true},
+		{a: "10.0.0.0/16", b: "10.1.0.0/16", expected: false},
+		{a: "10.0.0.0/24", b: "10.0.0.100-10.0.1.5", expected: true},
+		{a: "10.0.0.1-10.0.0.10", b: "10.0.0.11-10.0.0.20", expected: false},
+		{a: "2001:db8::/32", b: "2001:db8::1", expected: true},
+		{a: "2001:db8::/32", b: "10.0.0.1", expected: false},
+		{a: "10.0.0.1", b: "acc-test.com", expected: false},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			a, err := ParseAddress(c.a)
+			assert.NoError(t, err)
+
+			b, err := ParseAddress(c.b)
+			assert.NoError(t, err)
+
+			assert.Equal(t, c.expected, a.Overlaps(b))
+			assert.Equal(t, c.expected, b.Overlaps(a))
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package utils
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+)
+
+const (
+	DomainListFormatAuto    = "auto"
+	DomainListFormatHosts   = "hosts"
+	DomainListFormatAdblock = "adblock"
+	DomainListFormatPlain   = "plain"
+
+	adblockDomainPrefix    = "||"
+	adblockExceptionPrefix = "@@"
+	adblockSeparator       = "^"
+	adblockOptions         = "$"
+	wildcardDomainPrefix   = "*."
+)
+
+var (
+	ErrUnsupportedDomainListFormat = errors.New("unsupported domain list format")
+	ErrNotADomain                  = errors.New("not a domain")
+
+	//nolint:gochecknoglobals
+	DomainListFormats = []string{DomainListFormatAuto, DomainListFormatHosts, DomainListFormatAdblock, DomainListFormatPlain}
+
+	// hostnames every hosts file maps to loopback addresses, they are never filtering rules.
+	//nolint:gochecknoglobals
+	hostsFileReservedNames = MakeLookupMap([]string{
+		"localhost", "localhost.localdomain", "local", "broadcasthost",
+		"ip6-localhost", "ip6-loopback", "ip6-localnet", "ip6-mcastprefix",
+		"ip6-allnodes", "ip6-allrouters", "ip6-allhosts", "0.0.0.0",
+	})
+)
+
+// DomainList is the result of parsing a hosts file, an Adblock filter list or a plain list of domains.
+type DomainList struct {
+	// Domains are normalized, de-duplicated and sorted.
+	Domains []string
+	// Invalid holds the entries that look like rules but are not valid domains.
+	Invalid []string
+	// Skipped is the number of rules that can't be expressed as a domain, e.g. Adblock exceptions or URL patterns.
+	Skipped int
+}
+
+// Hash returns a stable checksum of the domains, suitable as a keeper or for comparing lists.
+func (l *DomainList) Hash() string {
+	sum := sha256.Sum256([]byte(strings.Join(l.Domains, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseDomainList parses the content in the given format. The auto format detects the format of each line.
+func ParseDomainList(content, format string) (*DomainList, error) {
+	if format == "" {
+		format = DomainListFormatAuto
+	}
+
+	if !MakeLookupMap(DomainListFormats)[format] {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedDomainListFormat, format)
+	}
+
+	list := &DomainList{}
+	domains := make(map[string]bool)
+	invalid := make(map[string]bool)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	// lines of huge lists may exceed the default token size
+	scanner.Buffer(nil, max(len(content)+1, bufio.MaxScanTokenSize))
+
+	for scanner.Scan() {
+		entries, ok := parseDomainListLine(strings.TrimSpace(scanner.Text()), format)
+		if !ok {
+			list.Skipped++
+
+			continue
+		}
+
+		for _, entry := range entries {
+			domain, err := NormalizeDomain(entry)
+			if err != nil {
+				invalid[entry] = true
+
+				continue
+			}
+
+			domains[domain] = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read domain list: %w", err)
+	}
+
+	list.Domains = MapKeys(domains)
+	list.Invalid = MapKeys(invalid)
+
+	sort.Strings(list.Domains)
+	sort.Strings(list.Invalid)
+
+	return list, nil
+}
+
+// NormalizeDomain lower cases the domain, strips the trailing dot and a leading wildcard label,
+// as DNS filtering rules always apply to subdomains, and validates it as an FQDN.
+func NormalizeDomain(value string) (string, error) {
+	domain := strings.TrimPrefix(strings.TrimSpace(value), wildcardDomainPrefix)
+
+	address, err := ParseAddress(domain)
+	if err != nil {
+		return "", err
+	}
+
+	if address.Type != AddressTypeFQDN || !strings.Contains(address.Value, ".") {
+		return "", fmt.Errorf("%w: %q", ErrNotADomain, value)
+	}
+
+	return address.Value, nil
+}
+
+// parseDomainListLine returns the domain entries of a line, or false if the line is a rule that
+// can't be expressed as a domain. Comments and blank lines have no entries.
+func parseDomainListLine(line, format string) ([]string, bool) {
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil, true
+	}
+
+	if format == DomainListFormatAuto {
+		format = detectDomainListLineFormat(line)
+	}
+
+	switch format {
+	case DomainListFormatHosts:
+		return parseHostsLine(line)
+	case DomainListFormatAdblock:
+		return parseAdblockLine(line)
+	default:
+		return strings.Fields(stripComment(line)), true
+	}
+}
+
+func detectDomainListLineFormat(line string) string {
+	switch {
+	case strings.HasPrefix(line, "!"), strings.HasPrefix(line, "["),
+		strings.HasPrefix(line, adblockDomainPrefix), strings.HasPrefix(line, adblockExceptionPrefix):
+		return DomainListFormatAdblock
+	case len(strings.Fields(stripComment(line))) > 1:
+		return DomainListFormatHosts
+	default:
+		return DomainListFormatPlain
+	}
+}
+
+// parseHostsLine parses "<ip> <hostname> [<hostname>...]" lines.
+func parseHostsLine(line string) ([]string, bool) {
+	fields := strings.Fields(stripComment(line))
+	if len(fields) == 0 {
+		return nil, true
+	}
+
+	if _, err := netip.ParseAddr(fields[0]); err != nil || len(fields) < 2 { //nolint:mnd
+		return nil, false
+	}
+
+	return Filter(fields[1:], func(name string) bool {
+		return !hostsFileReservedNames[strings.ToLower(name)]
+	}), true
+}
+
+// parseAdblockLine parses the "||example.com^" domain rules, other rules are skipped.
+func parseAdblockLine(line string) ([]string, bool) {
+	// comments and the "[Adblock Plus 2.0]" header
+	if strings.HasPrefix(line, "!") || strings.HasPrefix(line, "[") {
+		return nil, true
+	}
+
+	if !strings.HasPrefix(line, adblockDomainPrefix) {
+		return nil, false
+	}
+
+	rule, options, _ := strings.Cut(strings.TrimPrefix(line, adblockDomainPrefix), adblockOptions)
+
+	// rules limited to some requests can't be enforced by DNS filtering
+	if options != "" && options != "important" {
+		return nil, false
+	}
+
+	domain, rest, found := strings.Cut(rule, adblockSeparator)
+	if !found || rest != "" {
+		return nil, false
+	}
+
+	return []string{domain}, true
+}
+
+func stripComment(line string) string {
+	line, _, _ = strings.Cut(line, "#")
+
+	return line
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDomainList(t *testing.T) {
+	cases := []struct {
+		content  string
+		format   string
+		expected *DomainList
+	}{
+		{
+			content:  "",
+			format:   DomainListFormatAuto,
+			expected: &DomainList{Domains: []string{}, Invalid: []string{}},
+		},
+		{
+			content: `# hosts file
+127.0.0.1 localhost
+::1 ip6-localhost ip6-loopback
+0.0.0.0 ads.example.com tracker.example.com # trackers
+0.0.0.0 Ads.Example.com.
+`,
+			format:   DomainListFormatHosts,
+			expected: &DomainList{Domains: []string{"ads.example.com", "tracker.example.com"}, Invalid: []string{}},
+		},
+		{
+			content: `[Adblock Plus 2.0]
+! Title: test list
+||ads.example.com^
+||metrics.example.net^$important
+@@||allowed.example.com^
+||example.org/banner.png
+||video.example.com^$third-party
+`,
+			format:   DomainListFormatAdblock,
+			expected: &DomainList{Domains: []string{"ads.example.com", "metrics.example.net"}, Invalid: []string{}, Skipped: 3},
+		},
+		{
+			content: `example.com
+*.wildcard.example.com
+bad_label-.example.com
+localhost
+10.0.0.1
+`,
+			format:   DomainListFormatPlain,
+			expected: &DomainList{Domains: []string{"example.com", "wildcard.example.com"}, Invalid: []string{"10.0.0.1", "bad_label-.example.com", "localhost"}},
+		},
+		{
+			content: `! mixed list
+||ads.example.com^
+0.0.0.0 tracker.example.com
+plain.example.com # comment
+`,
+			format:   DomainListFormatAuto,
+			expected: &DomainList{Domains: []string{"ads.example.com", "plain.example.com", "tracker.example.com"}, Invalid: []string{}},
+		},
+	}
+
+	for n, c := range cases {
+		t.Run(fmt.Sprintf("case_%d", n), func(t *testing.T) {
+			actual, err := ParseDomainList(c.content, c.format)
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, actual)
+		})
+	}
+}
+
+func TestParseDomainListUnsupportedFormat(t *testing.T) {
+	list, err := ParseDomainList("example.com", "csv")
+
+	assert.Nil(t, list)
+	assert.ErrorIs(t, err, ErrUnsupportedDomainListFormat)
+}
+
+func TestDomainListHash(t *testing.T) {
+	listA, _ := ParseDomainList("b.example.com\na.example.com", DomainListFormatPlain)
+	listB, _ := ParseDomainList("0.0.0.0 a.example.com b.example.com", DomainListFormatHosts)
+	listC, _ := ParseDomainList("a.example.com", DomainListFormatPlain)
+
+	assert.Equal(t, listA.Hash(), listB.Hash())
+	assert.NotEqual(t, listA.Hash(), listC.Hash())
+}
@@ -2,24 +2,29 @@ package twingate
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/attr"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/client"
+	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/customvalidator"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/model"
 	twingateDatasource "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/datasource"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/providerdata"
 	twingateResource "github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/provider/resource"
 	"github.com/Twingate/terraform-provider-twingate/v4/twingate/internal/utils"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	tfattr "github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -27,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -44,6 +50,8 @@ const (
 	EnvHTTPMaxRetry = "TWINGATE_HTTP_MAX_RETRY"
 )
 
+var ErrInvalidRetryWait = errors.New("invalid retry wait")
+
 var _ provider.Provider = &Twingate{}
 
 type Twingate struct {
@@ -52,13 +60,21 @@ type Twingate struct {
 }
 
 type twingateProviderModel struct {
-	APIToken     types.String `tfsdk:"api_token"`
-	Network      types.String `tfsdk:"network"`
-	URL          types.String `tfsdk:"url"`
-	HTTPTimeout  types.Int64  `tfsdk:"http_timeout"`
-	HTTPMaxRetry types.Int64  `tfsdk:"http_max_retry"`
-	Cache        types.Object `tfsdk:"cache"`
-	DefaultTags  types.Object `tfsdk:"default_tags"`
+	APIToken       types.String `tfsdk:"api_token"`
+	Network        types.String `tfsdk:"network"`
+	URL            types.String `tfsdk:"url"`
+	HTTPTimeout    types.Int64  `tfsdk:"http_timeout"`
+	HTTPMaxRetry   types.Int64  `tfsdk:"http_max_retry"`
+	HTTPProxy      types.String `tfsdk:"http_proxy"`
+	NoProxy        types.String `tfsdk:"no_proxy"`
+	CABundleFile   types.String `tfsdk:"ca_bundle_file"`
+	CABundle       types.String `tfsdk:"ca_bundle"`
+	ClientCertFile types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile  types.String `tfsdk:"client_key_file"`
+	Cache          types.Object `tfsdk:"cache"`
+	DefaultTags    types.Object `tfsdk:"default_tags"`
+	Telemetry      types.Object `tfsdk:"telemetry"`
+	Client         types.Object `tfsdk:"client"`
 }
 
 func New(agent, version string) func() provider.Provider {
@@ -101,12 +117,54 @@ func (t Twingate) Schema(ctx context.Context, request provider.SchemaRequest, re
 			attr.HTTPTimeout: schema.Int64Attribute{
 				Optional: true,
 				Description: fmt.Sprintf("Specifies a time limit in seconds for the http requests made. The default value is %s seconds.\n"+
-					"Alternatively, this can be specified using the %s environment variable", DefaultHTTPTimeout, EnvHTTPTimeout),
+					"It is the default `%s` of the operations in the `%s` block.\n"+
+					"Alternatively, this can be specified using the %s environment variable", DefaultHTTPTimeout, attr.Timeout, attr.Client, EnvHTTPTimeout),
 			},
 			attr.HTTPMaxRetry: schema.Int64Attribute{
 				Optional: true,
 				Description: fmt.Sprintf("Specifies a retry limit for the http requests made. The default value is %s.\n"+
-					"Alternatively, this can be specified using the %s environment variable", DefaultHTTPMaxRetry, EnvHTTPMaxRetry),
+					"It is the default `%s` of the operations in the `%s` block.\n"+
+					"Alternatively, this can be specified using the %s environment variable", DefaultHTTPMaxRetry, attr.MaxRetries, attr.Client, EnvHTTPMaxRetry),
+			},
+			attr.HTTPProxy: schema.StringAttribute{
+				Optional: true,
+				Description: "The URL of the proxy used for the API requests, e.g. `http://proxy.example.com:3128`. " +
+					"Defaults to the standard `HTTPS_PROXY` and `HTTP_PROXY` environment variables.\n" +
+					fmt.Sprintf("Alternatively, this can be specified using the %s environment variable.", client.EnvHTTPProxy),
+			},
+			attr.NoProxy: schema.StringAttribute{
+				Optional: true,
+				Description: "A comma-separated list of hosts, domains and CIDRs the API requests are not proxied for. " +
+					"Defaults to the standard `NO_PROXY` environment variable.\n" +
+					fmt.Sprintf("Alternatively, this can be specified using the %s environment variable.", client.EnvNoProxy),
+			},
+			attr.CABundleFile: schema.StringAttribute{
+				Optional: true,
+				Description: "The path of a PEM file with CA certificates trusted in addition to the system ones, " +
+					"e.g. the CA of a TLS-inspecting proxy.\n" +
+					fmt.Sprintf("Alternatively, this can be specified using the %s environment variable.", client.EnvCABundleFile),
+			},
+			attr.CABundle: schema.StringAttribute{
+				Optional: true,
+				Description: "PEM-encoded CA certificates trusted in addition to the system ones, " +
+					"e.g. the CA of a TLS-inspecting proxy.\n" +
+					fmt.Sprintf("Alternatively, this can be specified using the %s environment variable.", client.EnvCABundle),
+			},
+			attr.ClientCertFile: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The path of the PEM client certificate used for mutual TLS, requires `%s`.\n", attr.ClientKeyFile) +
+					fmt.Sprintf("Alternatively, this can be specified using the %s environment variable.", client.EnvClientCertFile),
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(attr.ClientKeyFile)),
+				},
+			},
+			attr.ClientKeyFile: schema.StringAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("The path of the PEM private key of the client certificate used for mutual TLS, requires `%s`.\n", attr.ClientCertFile) +
+					fmt.Sprintf("Alternatively, this can be specified using the %s environment variable.", client.EnvClientKeyFile),
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot(attr.ClientCertFile)),
+				},
 			},
 			attr.Cache: schema.SingleNestedAttribute{
 				Optional:    true,
@@ -213,6 +271,58 @@ func (t Twingate) Schema(ctx context.Context, request provider.SchemaRequest, re
 					},
 				},
 			},
+			attr.Client: schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Specifies the timeouts, retries, page size and concurrency of the API operations.",
+				Attributes: map[string]schema.Attribute{
+					attr.PageSize: schema.Int64Attribute{
+						Optional: true,
+						Description: fmt.Sprintf("The number of items read per page of lists. The default value is `%d`.\n"+
+							"Alternatively, this can be specified using the %s environment variable.", client.DefaultPageSize, client.EnvPageLimit),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					attr.MaxConcurrentMutations: schema.Int64Attribute{
+						Optional: true,
+						Description: fmt.Sprintf("The maximum number of mutations sent to the API at the same time. The default value is `%d`.\n"+
+							"Alternatively, this can be specified using the %s environment variable.", client.DefaultMaxConcurrentMutations, client.EnvRateLimit),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					attr.Query: operationClassSchema("Specifies the timeout and retries of the queries."),
+					attr.Mutation: operationClassSchema("Specifies the timeout and retries of the mutations. Set `max_retries` to `0` to never retry requests that create or change objects. " +
+						"A timed-out create of a resource, group or DNS filtering profile is retried after looking up the object, which is adopted if it was created. " +
+						"The objects matching it before the create are never adopted."),
+					attr.Pagination: operationClassSchema("Specifies the timeout and retries of the requests reading the next pages of lists, e.g. the full reads of resources."),
+				},
+			},
+			attr.Telemetry: schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Specifies the export of OpenTelemetry traces and metrics for the API calls made by the provider.",
+				Attributes: map[string]schema.Attribute{
+					attr.Exporter: schema.StringAttribute{
+						Optional: true,
+						Description: fmt.Sprintf("The telemetry exporter: %s. The default value is `%s`, which disables telemetry.\n"+
+							"Alternatively, this can be specified using the %s environment variable.", utils.DocList(client.TelemetryExporters), client.TelemetryExporterNone, client.EnvTelemetryExporter),
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.TelemetryExporters...),
+						},
+					},
+					attr.Endpoint: schema.StringAttribute{
+						Optional: true,
+						Description: fmt.Sprintf("The base URL of the OTLP/HTTP collector used by the `%s` exporter, e.g. `http://localhost:4318`. "+
+							"Defaults to the standard `OTEL_EXPORTER_OTLP_*` environment variables.\n"+
+							"Alternatively, this can be specified using the %s environment variable.", client.TelemetryExporterOTLP, client.EnvTelemetryEndpoint),
+					},
+					attr.FilePath: schema.StringAttribute{
+						Optional: true,
+						Description: fmt.Sprintf("The path of the file the `%s` exporter appends the spans and metrics to as JSON lines.\n"+
+							"Alternatively, this can be specified using the %s environment variable.", client.TelemetryExporterFile, client.EnvTelemetryFilePath),
+					},
+				},
+			},
 			attr.DefaultTags: schema.SingleNestedAttribute{
 				Optional:    true,
 				Description: "A default set of tags applied globally to all resources created by the provider.",
@@ -228,6 +338,44 @@ func (t Twingate) Schema(ctx context.Context, request provider.SchemaRequest, re
 	}
 }
 
+func operationClassSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			attr.Timeout: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The time limit of each request, e.g. `60s` or `2m`. The default value is the `%s`.", attr.HTTPTimeout),
+				Validators: []validator.String{
+					customvalidator.DurationAtLeast(time.Second),
+				},
+			},
+			attr.MaxRetries: schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("The retry budget of each operation, shared by the retries of failed requests and of transient API errors. "+
+					"The default value is the `%s`.", attr.HTTPMaxRetry),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			attr.RetryWaitMin: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The minimum wait between retries, the wait grows exponentially. The default value is `%s`.", client.DefaultRetryWaitMin),
+				Validators: []validator.String{
+					customvalidator.DurationAtLeast(0),
+				},
+			},
+			attr.RetryWaitMax: schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum wait between retries. The default value is `%s`.", client.DefaultRetryWaitMax),
+				Validators: []validator.String{
+					customvalidator.DurationAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
 //nolint:funlen
 func (t Twingate) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
 	var config twingateProviderModel
@@ -253,6 +401,18 @@ func (t Twingate) Configure(ctx context.Context, request provider.ConfigureReque
 	httpTimeout = overrideIntWithConfig(config.HTTPTimeout, httpTimeout)
 	httpMaxRetry = overrideIntWithConfig(config.HTTPMaxRetry, httpMaxRetry)
 
+	if apiToken == "" && !config.APIToken.IsUnknown() {
+		response.Diagnostics.AddAttributeError(
+			path.Root(attr.APIToken),
+			"Missing Twingate "+attr.APIToken,
+			fmt.Sprintf("The provider cannot create the Twingate API client as there is a missing or empty value for the Twingate %s. "+
+				"Set the %s value in the configuration or use the %s environment variable. "+
+				"If either is already set, ensure the value is not empty.", attr.APIToken, attr.APIToken, EnvAPIToken),
+		)
+
+		return
+	}
+
 	if network == "" {
 		response.Diagnostics.AddAttributeError(
 			path.Root(attr.Network),
@@ -265,6 +425,17 @@ func (t Twingate) Configure(ctx context.Context, request provider.ConfigureReque
 		return
 	}
 
+	operationOpts, err := getOperationOptions(config.Client, time.Duration(httpTimeout)*time.Second, httpMaxRetry)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root(attr.Client),
+			"Issue in configuring Twingate "+attr.Client,
+			fmt.Sprintf("Error: %v", err.Error()),
+		)
+
+		return
+	}
+
 	cacheOpts, err := getCacheOptions(config.Cache)
 	if err != nil {
 		response.Diagnostics.AddAttributeError(
@@ -276,17 +447,71 @@ func (t Twingate) Configure(ctx context.Context, request provider.ConfigureReque
 		return
 	}
 
-	regionalURL := resolveRegionalURL(network, url, time.Duration(httpTimeout)*time.Second, httpMaxRetry, apiToken, t.agent, t.version)
+	telemetryOpts := getTelemetryOptions(config.Telemetry)
+	if telemetryOpts.Exporter == client.TelemetryExporterFile && telemetryOpts.FilePath == "" {
+		response.Diagnostics.AddAttributeError(
+			path.Root(attr.Telemetry).AtName(attr.FilePath),
+			"Missing Twingate "+attr.Telemetry+" "+attr.FilePath,
+			fmt.Sprintf("The %s telemetry exporter requires the %s value in the configuration or the %s environment variable.",
+				client.TelemetryExporterFile, attr.FilePath, client.EnvTelemetryFilePath),
+		)
+
+		return
+	}
+
+	if err := client.SetupTelemetry(ctx, telemetryOpts, t.version); err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root(attr.Telemetry),
+			"Issue in configuring Twingate "+attr.Telemetry,
+			fmt.Sprintf("Error: %v", err.Error()),
+		)
+
+		return
+	}
+
+	httpTransport, err := client.NewHTTPTransport(getTransportOptions(config))
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Issue in configuring Twingate API client transport",
+			fmt.Sprintf("Error: %v. Check the %s, %s, %s, %s, %s and %s values.", err.Error(),
+				attr.HTTPProxy, attr.NoProxy, attr.CABundleFile, attr.CABundle, attr.ClientCertFile, attr.ClientKeyFile),
+		)
+
+		return
+	}
+
+	regionalURL, err := resolveRegionalURL(ctx, network, url, operationOpts.Query.Timeout, operationOpts.Query.MaxRetries, httpTransport, apiToken, t.agent, t.version)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Failed to verify the Twingate API certificate",
+			fmt.Sprintf("Error: %v. If the API is reached through a TLS-inspecting proxy, set %s or %s to the CA certificate of the proxy. "+
+				"If the certificate name does not match, check the %s, %s, %s and %s values.", err.Error(),
+				attr.CABundleFile, attr.CABundle, attr.Network, attr.URL, attr.HTTPProxy, attr.NoProxy),
+		)
+
+		return
+	}
+
 	client := client.NewClient(
 		ctx,
 		regionalURL,
 		apiToken,
-		time.Duration(httpTimeout)*time.Second,
-		httpMaxRetry,
+		operationOpts,
+		httpTransport,
 		t.agent,
 		t.version,
 		cacheOpts)
 
+	// the token can't be validated before the values computed by other resources are known
+	if !config.APIToken.IsUnknown() && !config.Network.IsUnknown() {
+		err = client.ValidateAPIToken(ctx)
+		if diagnostic := apiTokenDiagnostic(err, network, regionalURL); diagnostic != nil {
+			response.Diagnostics.Append(diagnostic)
+
+			return
+		}
+	}
+
 	providerData := &providerdata.ProviderData{
 		Client: client,
 		Config: providerdata.Config{
@@ -302,11 +527,45 @@ func (t Twingate) Configure(ctx context.Context, request provider.ConfigureReque
 	response.EphemeralResourceData = providerData
 }
 
-// resolveRegionalURL returns the regional URL without a slash at the end.
-func resolveRegionalURL(network, url string, timeout time.Duration, retryMax int, apiToken, agent, version string) string {
+// ShutdownTelemetry flushes the traces and metrics exported by the configured providers.
+func ShutdownTelemetry(ctx context.Context) error {
+	return client.ShutdownTelemetry(ctx) //nolint:wrapcheck
+}
+
+// apiTokenDiagnostic returns the error diagnostic of an invalid API token or network. The other errors, e.g.
+// when the API is unavailable, don't fail the configuration: they are reported by the operations of the resources.
+func apiTokenDiagnostic(err error, network, regionalURL string) diag.Diagnostic {
+	var dnsErr *net.DNSError
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, client.ErrAuthExpired), errors.Is(err, client.ErrPermissionDenied):
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root(attr.APIToken),
+			"Invalid Twingate "+attr.APIToken,
+			fmt.Sprintf("The Twingate API rejected the %s for the %s network: %v. "+
+				"Check that the token was created in the Admin Console of this network, and that it has not expired or been revoked.",
+				attr.APIToken, network, err),
+		)
+	case errors.Is(err, client.ErrEndpointNotFound), errors.As(err, &dnsErr):
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root(attr.Network),
+			"Unknown Twingate "+attr.Network,
+			fmt.Sprintf("The Twingate API of the %s network was not found at %s: %v. Check the %s and %s values.",
+				network, regionalURL, err, attr.Network, attr.URL),
+		)
+	}
+
+	return nil
+}
+
+// resolveRegionalURL returns the regional URL without a slash at the end. It falls back to the network URL
+// on failures, except when the API certificate can't be verified.
+func resolveRegionalURL(ctx context.Context, network, url string, timeout time.Duration, retryMax int, httpTransport http.RoundTripper, apiToken, agent, version string) (string, error) {
 	correlationID, _ := uuid.GenerateUUID()
 	originalURL := client.SafeURL(fmt.Sprintf("https://%s.%s", network, url))
-	httpClient := client.NewCustomRetryableClient(timeout, retryMax, apiToken, agent, version, correlationID)
+	httpClient := client.NewCustomRetryableClient(timeout, retryMax, httpTransport, apiToken, agent, version, correlationID)
 	resp, err := httpClient.Get(originalURL)
 
 	defer func() {
@@ -315,20 +574,24 @@ func resolveRegionalURL(network, url string, timeout time.Duration, retryMax int
 		}
 
 		if err := resp.Body.Close(); err != nil {
-			log.Printf("[TWINGATE_LOG] [ERR] Failed to close response body: %v", err)
+			tflog.Error(ctx, "Failed to close response body", map[string]any{"error": err.Error()})
 		}
 	}()
 
 	if err != nil {
-		log.Printf("[TWINGATE_LOG] [ERR] Failed to resolve regional URL: %v", err)
+		tflog.Error(ctx, "Failed to resolve regional URL", map[string]any{"url": originalURL, "error": err.Error()})
 
-		return originalURL
+		if errors.Is(err, client.ErrTLSCertificate) {
+			return "", err //nolint:wrapcheck
+		}
+
+		return originalURL, nil
 	}
 
 	resolvedURL := client.SafeURL("https://" + resp.Request.URL.Host)
-	log.Printf("[TWINGATE_LOG] [INFO] Resolved regional URL: %s -> %s", originalURL, resolvedURL) // #nosec G706
+	tflog.Info(ctx, "Resolved regional URL", map[string]any{"url": originalURL, "regional_url": resolvedURL})
 
-	return resolvedURL
+	return resolvedURL, nil
 }
 
 func getCacheOptions(config types.Object) (client.CacheOptions, error) {
@@ -369,6 +632,99 @@ func getCacheOptions(config types.Object) (client.CacheOptions, error) {
 	}, nil
 }
 
+func getTelemetryOptions(config types.Object) client.TelemetryOptions {
+	opts := client.TelemetryOptions{
+		Exporter: os.Getenv(client.EnvTelemetryExporter),
+		Endpoint: os.Getenv(client.EnvTelemetryEndpoint),
+		FilePath: os.Getenv(client.EnvTelemetryFilePath),
+	}
+
+	if config.IsNull() || config.IsUnknown() {
+		return opts
+	}
+
+	attrs := config.Attributes()
+
+	opts.Exporter = overrideStrWithConfig(attrs[attr.Exporter].(types.String), opts.Exporter)
+	opts.Endpoint = overrideStrWithConfig(attrs[attr.Endpoint].(types.String), opts.Endpoint)
+	opts.FilePath = overrideStrWithConfig(attrs[attr.FilePath].(types.String), opts.FilePath)
+
+	return opts
+}
+
+// getOperationOptions returns the options of the client block, the operations default to the HTTP timeout and retries.
+func getOperationOptions(config types.Object, httpTimeout time.Duration, httpMaxRetry int) (client.OperationOptions, error) {
+	opts := client.DefaultOperationOptions(httpTimeout, httpMaxRetry)
+	opts.PageSize = withDefault(mustGetInt(os.Getenv(client.EnvPageLimit)), client.DefaultPageSize)
+	opts.MaxConcurrentMutations = withDefault(mustGetInt(os.Getenv(client.EnvRateLimit)), client.DefaultMaxConcurrentMutations)
+
+	if config.IsNull() || config.IsUnknown() {
+		return opts, nil
+	}
+
+	attrs := config.Attributes()
+	opts.PageSize = overrideIntWithConfig(attrs[attr.PageSize].(types.Int64), opts.PageSize)
+	opts.MaxConcurrentMutations = overrideIntWithConfig(attrs[attr.MaxConcurrentMutations].(types.Int64), opts.MaxConcurrentMutations)
+
+	var err error
+
+	if opts.Query, err = getRetryOptions(attrs[attr.Query].(types.Object), opts.Query); err != nil {
+		return opts, fmt.Errorf("invalid %s options: %w", attr.Query, err)
+	}
+
+	if opts.Mutation, err = getRetryOptions(attrs[attr.Mutation].(types.Object), opts.Mutation); err != nil {
+		return opts, fmt.Errorf("invalid %s options: %w", attr.Mutation, err)
+	}
+
+	if opts.Pagination, err = getRetryOptions(attrs[attr.Pagination].(types.Object), opts.Pagination); err != nil {
+		return opts, fmt.Errorf("invalid %s options: %w", attr.Pagination, err)
+	}
+
+	return opts, nil
+}
+
+func getRetryOptions(config types.Object, opts client.RetryOptions) (client.RetryOptions, error) {
+	if config.IsNull() || config.IsUnknown() {
+		return opts, nil
+	}
+
+	attrs := config.Attributes()
+
+	var err error
+
+	if opts.Timeout, err = overrideDurationWithConfig(attrs[attr.Timeout].(types.String), opts.Timeout); err != nil {
+		return opts, err
+	}
+
+	opts.MaxRetries = overrideIntWithConfig(attrs[attr.MaxRetries].(types.Int64), opts.MaxRetries)
+
+	if opts.RetryWaitMin, err = overrideDurationWithConfig(attrs[attr.RetryWaitMin].(types.String), opts.RetryWaitMin); err != nil {
+		return opts, err
+	}
+
+	if opts.RetryWaitMax, err = overrideDurationWithConfig(attrs[attr.RetryWaitMax].(types.String), opts.RetryWaitMax); err != nil {
+		return opts, err
+	}
+
+	if opts.RetryWaitMin > opts.RetryWaitMax {
+		return opts, fmt.Errorf("%w: %s %s is greater than %s %s", ErrInvalidRetryWait,
+			attr.RetryWaitMin, opts.RetryWaitMin, attr.RetryWaitMax, opts.RetryWaitMax)
+	}
+
+	return opts, nil
+}
+
+func getTransportOptions(config twingateProviderModel) client.TransportOptions {
+	return client.TransportOptions{
+		ProxyURL:       overrideStrWithConfig(config.HTTPProxy, os.Getenv(client.EnvHTTPProxy)),
+		NoProxy:        overrideStrWithConfig(config.NoProxy, os.Getenv(client.EnvNoProxy)),
+		CABundleFile:   overrideStrWithConfig(config.CABundleFile, os.Getenv(client.EnvCABundleFile)),
+		CABundle:       overrideStrWithConfig(config.CABundle, os.Getenv(client.EnvCABundle)),
+		ClientCertFile: overrideStrWithConfig(config.ClientCertFile, os.Getenv(client.EnvClientCertFile)),
+		ClientKeyFile:  overrideStrWithConfig(config.ClientKeyFile, os.Getenv(client.EnvClientKeyFile)),
+	}
+}
+
 func parseResourcesFilter(config types.Object) (*model.ResourcesFilter, error) {
 	if config.IsNull() || config.IsUnknown() {
 		//nolint:nilnil
@@ -486,6 +842,14 @@ func overrideStrWithConfig(cfg types.String, defaultValue string) string {
 	return defaultValue
 }
 
+func overrideDurationWithConfig(cfg types.String, defaultValue time.Duration) (time.Duration, error) {
+	if cfg.IsNull() {
+		return defaultValue, nil
+	}
+
+	return time.ParseDuration(cfg.ValueString()) //nolint:wrapcheck
+}
+
 func overrideIntWithConfig(cfg types.Int64, defaultValue int) int {
 	if !cfg.IsNull() {
 		return int(cfg.ValueInt64())
@@ -531,13 +895,17 @@ func (t Twingate) DataSources(ctx context.Context) []func() datasource.DataSourc
 		twingateDatasource.NewRemoteNetworkDatasource,
 		twingateDatasource.NewRemoteNetworksDatasource,
 		twingateDatasource.NewServiceAccountsDatasource,
+		twingateDatasource.NewServiceAccountKeysDatasource,
 		twingateDatasource.NewUserDatasource,
 		twingateDatasource.NewUsersDatasource,
 		twingateDatasource.NewSecurityPolicyDatasource,
 		twingateDatasource.NewSecurityPoliciesDatasource,
 		twingateDatasource.NewResourceDatasource,
 		twingateDatasource.NewResourcesDatasource,
+		twingateDatasource.NewResourceConflictsDatasource,
 		twingateDatasource.NewDNSFilteringProfileDatasource,
+		twingateDatasource.NewDNSFilteringProfilesDatasource,
+		twingateDatasource.NewDNSFilteringDomainListDatasource,
 		twingateDatasource.NewX509CertificateAuthorityDatasource,
 		twingateDatasource.NewSSHCertificateAuthorityDatasource,
 		twingateDatasource.NewGatewayDatasource,
@@ -555,12 +923,15 @@ func (t Twingate) Resources(ctx context.Context) []func() resource.Resource {
 		twingateResource.NewUserResource,
 		twingateResource.NewResourceResource,
 		twingateResource.NewDNSFilteringProfile,
+		twingateResource.NewDNSFilteringProfileOrder,
+		twingateResource.NewUsersBulkResource,
 		twingateResource.NewX509CertificateAuthorityResource,
 		twingateResource.NewSSHCertificateAuthorityResource,
 		twingateResource.NewGatewayResource,
 		twingateResource.NewSSHResourceResource,
 		twingateResource.NewKubernetesResourceResource,
 		twingateResource.NewGatewayConfigResource,
+		twingateResource.NewServiceKeyRotationResource,
 	}
 }
 
@@ -569,5 +940,8 @@ func (t Twingate) EphemeralResources(ctx context.Context) []func() ephemeral.Eph
 		func() ephemeral.EphemeralResource {
 			return twingateResource.NewEphemeralConnectorTokens()
 		},
+		func() ephemeral.EphemeralResource {
+			return twingateResource.NewEphemeralServiceKey()
+		},
 	}
 }